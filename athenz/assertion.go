@@ -309,7 +309,10 @@ func flattenPolicyAssertion(list []*zms.Assertion) []interface{} {
 	for _, assertion := range list {
 		role := strings.Split(assertion.Role, ROLE_SEPARATOR)[1]
 		resource := assertion.Resource
-		effect := assertion.Effect.String()
+		effect := zms.ALLOW.String()
+		if assertion.Effect != nil {
+			effect = assertion.Effect.String()
+		}
 		action := assertion.Action
 		caseSensitive := inferCaseSensitiveValue(action, resource)
 
@@ -319,7 +322,10 @@ func flattenPolicyAssertion(list []*zms.Assertion) []interface{} {
 			"action":         action,
 			"effect":         effect,
 			"case_sensitive": caseSensitive,
-			"id":             (int)(*assertion.Id),
+		}
+		// assertions of server templates are not stored yet and have no id
+		if assertion.Id != nil {
+			a["id"] = (int)(*assertion.Id)
 		}
 		if assertion.Conditions != nil {
 			a["condition"] = flattenAssertionConditions(assertion.Conditions.ConditionsList)
@@ -1,19 +1,33 @@
 package athenz
 
 const (
-	AUDIT_REF              = "done by terraform provider"
-	ROLE_SEPARATOR         = ":role."
-	GROUP_SEPARATOR        = ":group."
-	POLICY_SEPARATOR       = ":policy."
-	RESOURCE_SEPARATOR     = ":"
-	SERVICE_SEPARATOR      = "."
-	SUB_DOMAIN_SEPARATOR   = "."
-	PREFIX_USER_DOMAIN     = "home."
-	EXPIRATION_LAYOUT      = "2006-01-02 15:04:05"
-	MEMBER_EXPIRATION      = "member expiration"
-	DATE_PATTERN           = "[0-9][0-9][0-9][0-9]-[0-9][0-9]-[0-9][0-9] [0-9][0-9]:[0-9][0-9]:[0-9][0-9]"
-	MEMBER_REVIEW_REMINDER = "member review reminder"
-	LAST_REVIEWED_DATE     = "last reviewed date"
+	AUDIT_REF                = "done by terraform provider"
+	ROLE_SEPARATOR           = ":role."
+	GROUP_SEPARATOR          = ":group."
+	POLICY_SEPARATOR         = ":policy."
+	TEMPLATE_SEPARATOR       = ":template."
+	ENTITY_SEPARATOR         = ":entity."
+	MEMBER_SEPARATOR         = "/"
+	HOST_SEPARATOR           = "/"
+	PUBLIC_KEY_SEPARATOR     = "/"
+	TENANCY_SEPARATOR        = ":tenancy."
+	DEPENDENCY_SEPARATOR     = ":dependency."
+	RESOURCE_GROUP_SEPARATOR = "/"
+	RESOURCE_SEPARATOR       = ":"
+	SERVICE_SEPARATOR        = "."
+	SUB_DOMAIN_SEPARATOR     = "."
+	PREFIX_USER_DOMAIN       = "home."
+	EXPIRATION_LAYOUT        = "2006-01-02 15:04:05"
+	MEMBER_EXPIRATION        = "member expiration"
+	DATE_PATTERN             = "[0-9][0-9][0-9][0-9]-[0-9][0-9]-[0-9][0-9] [0-9][0-9]:[0-9][0-9]:[0-9][0-9]"
+	MEMBER_REVIEW_REMINDER   = "member review reminder"
+	LAST_REVIEWED_DATE       = "last reviewed date"
+	TEMPLATE_DOMAIN_KEYWORD  = "_domain_"
+	PENDING_STATE_DELETE     = "DELETE"
+	MIN_RSA_KEY_SIZE         = 2048
+	MEMBERSHIP_DIRECT        = "direct"
+	MEMBERSHIP_GROUP         = "group"
+	MEMBERSHIP_TRUST         = "trust"
 )
 
 // assertion conditions data keys
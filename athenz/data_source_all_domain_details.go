@@ -156,7 +156,7 @@ func DataSourceAllDomainDetails() *schema.Resource {
 func dataSourceAllDomainDetailsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	zmsClient := meta.(client.ZmsClient)
 	domainName := d.Get("name").(string)
-	domain, err := zmsClient.GetDomain(domainName)
+	domain, err := zmsClient.GetDomain(ctx, domainName)
 	switch v := err.(type) {
 	case rdl.ResourceError:
 		if v.Code == 404 {
@@ -236,28 +236,28 @@ func dataSourceAllDomainDetailsRead(ctx context.Context, d *schema.ResourceData,
 	if domain.Contacts != nil {
 		d.Set("contacts", domain.Contacts)
 	}
-	roleList, err := zmsClient.GetRoleList(domainName, nil, "")
+	roleList, err := zmsClient.GetRoleList(ctx, domainName, nil, "")
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	if err = d.Set("role_list", convertEntityNameListToStringList(roleList.Names)); err != nil {
 		return diag.FromErr(err)
 	}
-	policyList, err := zmsClient.GetPolicyList(domainName, nil, "")
+	policyList, err := zmsClient.GetPolicyList(ctx, domainName, nil, "")
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	if err = d.Set("policy_list", convertEntityNameListToStringList(policyList.Names)); err != nil {
 		return diag.FromErr(err)
 	}
-	serviceList, err := zmsClient.GetServiceIdentityList(domainName, nil, "")
+	serviceList, err := zmsClient.GetServiceIdentityList(ctx, domainName, nil, "")
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	if err = d.Set("service_list", convertEntityNameListToStringList(serviceList.Names)); err != nil {
 		return diag.FromErr(err)
 	}
-	groupList, err := zmsClient.GetGroups(domainName, nil)
+	groupList, err := zmsClient.GetGroups(ctx, domainName, nil, "", "")
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -0,0 +1,56 @@
+package athenz
+
+import (
+	"context"
+
+	"github.com/AthenZ/terraform-provider-athenz/client"
+	"github.com/ardielle/ardielle-go/rdl"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceDependentDomains() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDependentDomainsRead,
+		Schema: map[string]*schema.Schema{
+			"service": {
+				Type:             schema.TypeString,
+				Description:      "Full name of the provider service, <provider_domain>.<service>",
+				Required:         true,
+				ValidateDiagFunc: validatePatternFunc(SERVICE_NAME),
+			},
+			"names": {
+				Type:        schema.TypeList,
+				Description: "Names of the domains depending on the service",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceDependentDomainsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zmsClient := meta.(client.ZmsClient)
+	service := d.Get("service").(string)
+	domains, err := zmsClient.GetDependentDomainList(ctx, service)
+	switch v := err.(type) {
+	case rdl.ResourceError:
+		if v.Code == 404 {
+			return diag.Errorf("athenz service %s not found, update your data source query", service)
+		}
+		return diag.Errorf("error retrieving Athenz Dependent Domains: %s", v)
+	case rdl.Any:
+		return diag.FromErr(err)
+	}
+	names := make([]string, 0)
+	if domains != nil {
+		for _, name := range domains.Names {
+			names = append(names, string(name))
+		}
+	}
+	if err = d.Set("names", names); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(service)
+	return nil
+}
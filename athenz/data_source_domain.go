@@ -25,7 +25,7 @@ func DataSourceDomain() *schema.Resource {
 func dataSourceDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	zmsClient := meta.(client.ZmsClient)
 	domainName := d.Get("name").(string)
-	domain, err := zmsClient.GetDomain(domainName)
+	domain, err := zmsClient.GetDomain(ctx, domainName)
 	switch v := err.(type) {
 	case rdl.ResourceError:
 		if v.Code == 404 {
@@ -0,0 +1,57 @@
+package athenz
+
+import (
+	"context"
+
+	"github.com/AthenZ/terraform-provider-athenz/client"
+	"github.com/ardielle/ardielle-go/rdl"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceDomainQuota() *schema.Resource {
+	quotaSchema := map[string]*schema.Schema{
+		"domain": {
+			Type:             schema.TypeString,
+			Description:      "Name of the domain the quota applies to",
+			Required:         true,
+			ValidateDiagFunc: validatePatternFunc(DOMAIN_NAME),
+		},
+	}
+	for name, quotaField := range quotaFields {
+		quotaSchema[name] = &schema.Schema{
+			Type:        schema.TypeInt,
+			Description: quotaField.description,
+			Computed:    true,
+		}
+	}
+	return &schema.Resource{
+		ReadContext: dataSourceDomainQuotaRead,
+		Schema:      quotaSchema,
+	}
+}
+
+func dataSourceDomainQuotaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zmsClient := meta.(client.ZmsClient)
+	domainName := d.Get("domain").(string)
+	quota, err := zmsClient.GetQuota(ctx, domainName)
+	switch v := err.(type) {
+	case rdl.ResourceError:
+		if v.Code == 404 {
+			return diag.Errorf("athenz domain %s not found, update your data source query", domainName)
+		}
+		return diag.Errorf("error retrieving Athenz Quota: %s", v)
+	case rdl.Any:
+		return diag.FromErr(err)
+	}
+	if quota == nil {
+		return diag.Errorf("error retrieving Athenz Quota: %s", domainName)
+	}
+	for name, value := range flattenQuota(quota) {
+		if err = d.Set(name, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	d.SetId(domainName)
+	return nil
+}
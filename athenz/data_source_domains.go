@@ -0,0 +1,256 @@
+package athenz
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/AthenZ/athenz/clients/go/zms"
+	"github.com/AthenZ/terraform-provider-athenz/client"
+	"github.com/ardielle/ardielle-go/rdl"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceDomains() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDomainsRead,
+		Schema: map[string]*schema.Schema{
+			"prefix": {
+				Type:        schema.TypeString,
+				Description: "Only return the domains starting with the prefix",
+				Optional:    true,
+			},
+			"depth": {
+				Type:         schema.TypeInt,
+				Description:  "Only return the domains with at most this number of sub domain levels, -1 for no limit",
+				Optional:     true,
+				Default:      -1,
+				ValidateFunc: validation.IntAtLeast(-1),
+			},
+			"account": {
+				Type:        schema.TypeString,
+				Description: "Only return the domain mapped to the AWS account",
+				Optional:    true,
+			},
+			"product_id": {
+				Type:        schema.TypeString,
+				Description: "Only return the domain mapped to the product id",
+				Optional:    true,
+			},
+			"role_member": {
+				Type:             schema.TypeString,
+				Description:      "Only return the domains where the principal is a member of a role",
+				Optional:         true,
+				ValidateDiagFunc: validatePatternFunc(MEMBER_NAME),
+			},
+			"role_name": {
+				Type:        schema.TypeString,
+				Description: "Only return the domains where role_member is a member of the role with this name",
+				Optional:    true,
+			},
+			"tag_key": {
+				Type:        schema.TypeString,
+				Description: "Only return the domains with the tag",
+				Optional:    true,
+			},
+			"tag_value": {
+				Type:        schema.TypeString,
+				Description: "Only return the domains where the tag has this value, requires tag_key",
+				Optional:    true,
+			},
+			"business_service": {
+				Type:        schema.TypeString,
+				Description: "Only return the domains of the business service",
+				Optional:    true,
+			},
+			"modified_since": {
+				Type:             schema.TypeString,
+				Description:      "Only return the domains modified since this date (UTC). Format: 2006-01-02 15:04:05",
+				Optional:         true,
+				ValidateDiagFunc: validateDatePatternFunc(DATE_PATTERN, "modified since"),
+			},
+			"include_meta": {
+				Type:        schema.TypeBool,
+				Description: "Whether to return the metadata of the domains in domains, it requires a request per domain",
+				Optional:    true,
+				Default:     false,
+			},
+			"names": {
+				Type:        schema.TypeList,
+				Description: "Names of the matching domains",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"domains": {
+				Type:        schema.TypeList,
+				Description: "Metadata of the matching domains, only set with include_meta",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: dataSourceDomainMetaSchema(),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDomainMetaSchema() map[string]*schema.Schema {
+	meta := map[string]*schema.Schema{
+		"tags": {
+			Type:     schema.TypeMap,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"contacts": {
+			Type:     schema.TypeMap,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"enabled": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"audit_enabled": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+	}
+	for _, name := range []string{"name", "description", "org", "account", "product_id", "application_id", "business_service",
+		"environment", "slack_channel", "on_call", "user_authority_filter", "modified"} {
+		meta[name] = &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		}
+	}
+	return meta
+}
+
+func dataSourceDomainsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zmsClient := meta.(client.ZmsClient)
+	filter, err := expandDomainListFilter(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	names, err := getDomainNames(ctx, zmsClient, filter)
+	if err != nil {
+		return diag.Errorf("error retrieving Athenz Domains: %s", err)
+	}
+	if err = d.Set("names", names); err != nil {
+		return diag.FromErr(err)
+	}
+
+	domains := make([]interface{}, 0)
+	if d.Get("include_meta").(bool) {
+		for _, name := range names {
+			domain, err := zmsClient.GetDomain(ctx, name)
+			if err != nil {
+				return diag.Errorf("error retrieving Athenz domain %s: %s", name, err)
+			}
+			domains = append(domains, flattenDomainMeta(domain))
+		}
+	}
+	if err = d.Set("domains", domains); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(domainListFilterId(filter))
+	return nil
+}
+
+func expandDomainListFilter(d *schema.ResourceData) (client.DomainListFilter, error) {
+	filter := client.DomainListFilter{
+		Prefix:          d.Get("prefix").(string),
+		Account:         d.Get("account").(string),
+		ProductId:       d.Get("product_id").(string),
+		RoleMember:      d.Get("role_member").(string),
+		RoleName:        d.Get("role_name").(string),
+		TagKey:          d.Get("tag_key").(string),
+		TagValue:        d.Get("tag_value").(string),
+		BusinessService: d.Get("business_service").(string),
+	}
+	if filter.TagValue != "" && filter.TagKey == "" {
+		return filter, fmt.Errorf("in order to input tag_value, tag_key must be provided")
+	}
+	if filter.RoleName != "" && filter.RoleMember == "" {
+		return filter, fmt.Errorf("in order to input role_name, role_member must be provided")
+	}
+	if depth := d.Get("depth").(int); depth >= 0 {
+		value := int32(depth)
+		filter.Depth = &value
+	}
+	if modifiedSince := d.Get("modified_since").(string); modifiedSince != "" {
+		date, err := time.ParseInLocation(EXPIRATION_LAYOUT, modifiedSince, time.UTC)
+		if err != nil {
+			return filter, err
+		}
+		filter.ModifiedSince = date.Format(http.TimeFormat)
+	}
+	return filter, nil
+}
+
+// getDomainNames follows the pages of the domain list. ZMS answers 304 when
+// no domain was modified since the requested date.
+func getDomainNames(ctx context.Context, zmsClient client.ZmsClient, filter client.DomainListFilter) ([]string, error) {
+	names := make([]string, 0)
+	skip := ""
+	for {
+		domainList, err := zmsClient.GetDomainList(ctx, filter, skip)
+		if v, ok := err.(rdl.ResourceError); ok && v.Code == http.StatusNotModified {
+			return names, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if domainList == nil {
+			return names, nil
+		}
+		for _, name := range domainList.Names {
+			names = append(names, string(name))
+		}
+		if domainList.Next == "" || domainList.Next == skip {
+			return names, nil
+		}
+		skip = domainList.Next
+	}
+}
+
+func flattenDomainMeta(domain *zms.Domain) map[string]interface{} {
+	return map[string]interface{}{
+		"name":                  string(domain.Name),
+		"description":           domain.Description,
+		"org":                   string(domain.Org),
+		"enabled":               domain.Enabled == nil || *domain.Enabled,
+		"audit_enabled":         domain.AuditEnabled != nil && *domain.AuditEnabled,
+		"account":               domain.Account,
+		"product_id":            domain.ProductId,
+		"application_id":        domain.ApplicationId,
+		"business_service":      domain.BusinessService,
+		"environment":           domain.Environment,
+		"slack_channel":         domain.SlackChannel,
+		"on_call":               domain.OnCall,
+		"user_authority_filter": domain.UserAuthorityFilter,
+		"modified":              timestampToString(domain.Modified),
+		"tags":                  flattenTag(domain.Tags),
+		"contacts":              flattenDomainContacts(domain.Contacts),
+	}
+}
+
+func flattenDomainContacts(contacts map[zms.SimpleName]string) map[string]interface{} {
+	result := make(map[string]interface{}, len(contacts))
+	for key, value := range contacts {
+		result[string(key)] = value
+	}
+	return result
+}
+
+// domainListFilterId builds a stable id out of the configured filters.
+func domainListFilterId(filter client.DomainListFilter) string {
+	depth := ""
+	if filter.Depth != nil {
+		depth = fmt.Sprint(*filter.Depth)
+	}
+	return strings.Join([]string{"domains", filter.Prefix, depth, filter.Account, filter.ProductId, filter.RoleMember, filter.RoleName,
+		filter.TagKey, filter.TagValue, filter.BusinessService, filter.ModifiedSince}, "_")
+}
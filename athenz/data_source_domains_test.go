@@ -0,0 +1,75 @@
+package athenz
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/AthenZ/athenz/clients/go/zms"
+	"github.com/AthenZ/terraform-provider-athenz/client"
+	"github.com/ardielle/ardielle-go/rdl"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ast "gotest.tools/assert"
+)
+
+func TestDataSourceDomainsRead(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	clientMock := client.NewMockZmsClient(mockCtrl)
+	depth := int32(0)
+	filter := client.DomainListFilter{
+		Prefix:        "home",
+		Depth:         &depth,
+		TagKey:        "env",
+		TagValue:      "prod",
+		ModifiedSince: "Sun, 01 Jan 2023 10:00:00 GMT",
+	}
+	enabled := true
+	gomock.InOrder(
+		clientMock.EXPECT().GetDomainList(gomock.Any(), filter, "").Return(&zms.DomainList{Names: []zms.DomainName{"home.alice"}, Next: "home.alice"}, nil),
+		clientMock.EXPECT().GetDomainList(gomock.Any(), filter, "home.alice").Return(&zms.DomainList{Names: []zms.DomainName{"home.bob"}}, nil),
+		clientMock.EXPECT().GetDomain(gomock.Any(), "home.alice").Return(&zms.Domain{Name: "home.alice", Enabled: &enabled, BusinessService: "storage"}, nil),
+		clientMock.EXPECT().GetDomain(gomock.Any(), "home.bob").Return(&zms.Domain{Name: "home.bob"}, nil),
+	)
+
+	d := schema.TestResourceDataRaw(t, DataSourceDomains().Schema, map[string]interface{}{
+		"prefix":         "home",
+		"depth":          0,
+		"tag_key":        "env",
+		"tag_value":      "prod",
+		"modified_since": "2023-01-01 10:00:00",
+		"include_meta":   true,
+	})
+	diags := dataSourceDomainsRead(context.Background(), d, clientMock)
+	ast.Assert(t, !diags.HasError(), diags)
+	ast.DeepEqual(t, d.Get("names"), []interface{}{"home.alice", "home.bob"})
+	ast.Equal(t, d.Get("domains.#"), 2)
+	ast.Equal(t, d.Get("domains.0.business_service"), "storage")
+	ast.Equal(t, d.Get("domains.1.enabled"), true)
+	ast.Equal(t, d.Get("domains.1.audit_enabled"), false)
+}
+
+func TestDataSourceDomainsReadNotModified(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	clientMock := client.NewMockZmsClient(mockCtrl)
+	clientMock.EXPECT().GetDomainList(gomock.Any(), client.DomainListFilter{Account: "123456"}, "").Return(nil, rdl.ResourceError{Code: http.StatusNotModified})
+
+	d := schema.TestResourceDataRaw(t, DataSourceDomains().Schema, map[string]interface{}{
+		"account": "123456",
+	})
+	diags := dataSourceDomainsRead(context.Background(), d, clientMock)
+	ast.Assert(t, !diags.HasError(), diags)
+	ast.DeepEqual(t, d.Get("names"), []interface{}{})
+	ast.Equal(t, d.Get("domains.#"), 0)
+}
+
+func TestDataSourceDomainsReadInvalidFilter(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	clientMock := client.NewMockZmsClient(mockCtrl)
+	d := schema.TestResourceDataRaw(t, DataSourceDomains().Schema, map[string]interface{}{
+		"tag_value": "prod",
+	})
+	diags := dataSourceDomainsRead(context.Background(), d, clientMock)
+	ast.Assert(t, diags.HasError())
+	ast.Equal(t, diags[0].Summary, "in order to input tag_value, tag_key must be provided")
+}
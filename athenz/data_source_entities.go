@@ -0,0 +1,56 @@
+package athenz
+
+import (
+	"context"
+
+	"github.com/AthenZ/terraform-provider-athenz/client"
+	"github.com/ardielle/ardielle-go/rdl"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceEntities() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceEntitiesRead,
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:             schema.TypeString,
+				Description:      "Name of the domain to list the entities of",
+				Required:         true,
+				ValidateDiagFunc: validatePatternFunc(DOMAIN_NAME),
+			},
+			"names": {
+				Type:        schema.TypeList,
+				Description: "Names of the entities in the domain",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceEntitiesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zmsClient := meta.(client.ZmsClient)
+	domainName := d.Get("domain").(string)
+	entities, err := zmsClient.GetEntityList(ctx, domainName)
+	switch v := err.(type) {
+	case rdl.ResourceError:
+		if v.Code == 404 {
+			return diag.Errorf("athenz domain %s not found, update your data source query", domainName)
+		}
+		return diag.Errorf("error retrieving Athenz Entities: %s", v)
+	case rdl.Any:
+		return diag.FromErr(err)
+	}
+	names := make([]string, 0)
+	if entities != nil {
+		for _, name := range entities.Names {
+			names = append(names, string(name))
+		}
+	}
+	if err = d.Set("names", names); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(domainName + ENTITY_SEPARATOR)
+	return nil
+}
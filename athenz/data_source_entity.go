@@ -0,0 +1,63 @@
+package athenz
+
+import (
+	"context"
+
+	"github.com/AthenZ/terraform-provider-athenz/client"
+	"github.com/ardielle/ardielle-go/rdl"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceEntity() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceEntityRead,
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:             schema.TypeString,
+				Description:      "Name of the domain the entity belongs to",
+				Required:         true,
+				ValidateDiagFunc: validatePatternFunc(DOMAIN_NAME),
+			},
+			"name": {
+				Type:             schema.TypeString,
+				Description:      "Name of the entity",
+				Required:         true,
+				ValidateDiagFunc: validatePatternFunc(ENTITY_NAME),
+			},
+			"value": {
+				Type:        schema.TypeString,
+				Description: "Value of the entity, a JSON object",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceEntityRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zmsClient := meta.(client.ZmsClient)
+	domainName := d.Get("domain").(string)
+	entityName := d.Get("name").(string)
+	entity, err := zmsClient.GetEntity(ctx, domainName, entityName)
+	switch v := err.(type) {
+	case rdl.ResourceError:
+		if v.Code == 404 {
+			return diag.Errorf("athenz Entity %s not found, update your data source query", entityName)
+		}
+		return diag.Errorf("error retrieving Athenz Entity: %s", v)
+	case rdl.Any:
+		return diag.FromErr(err)
+	}
+	if entity == nil {
+		return diag.Errorf("error retrieving Athenz Entity: %s", entityName)
+	}
+	value, err := flattenEntityValue(entity.Value)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("value", value); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(domainName + ENTITY_SEPARATOR + entityName)
+	return nil
+}
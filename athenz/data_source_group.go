@@ -12,133 +12,18 @@ import (
 func DataSourceGroup() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceGroupRead,
-		Schema: map[string]*schema.Schema{
-			"domain": {
-				Type:     schema.TypeString,
-				Required: true,
-			},
-			"name": {
-				Type:     schema.TypeString,
-				Required: true,
-			},
-			"member": {
-				Type:        schema.TypeSet,
-				Description: "Users or services to be added as members",
-				Optional:    true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"name": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"expiration": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Default:  "",
-						},
-					},
-				},
-			},
-			"tags": {
-				Type:     schema.TypeMap,
-				Optional: true,
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
-				},
-			},
-			"settings": {
-				Type:        schema.TypeSet,
-				Description: "Advanced settings",
-				Optional:    true,
-				MaxItems:    1,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"user_expiry_days": {
-							Type:     schema.TypeInt,
-							Optional: true,
-						},
-						"service_expiry_days": {
-							Type:     schema.TypeInt,
-							Optional: true,
-						},
-						"max_members": {
-							Type:     schema.TypeInt,
-							Optional: true,
-						},
-					},
-				},
-			},
-			"self_serve": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  false,
-			},
-			"audit_enabled": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  false,
-			},
-			"self_renew": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  false,
-			},
-			"self_renew_mins": {
-				Type:     schema.TypeInt,
-				Optional: true,
-			},
-			"delete_protection": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  false,
-			},
-			"review_enabled": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  false,
-			},
-			"user_authority_filter": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Default:  "",
-			},
-			"user_authority_expiration": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Default:  "",
-			},
-			"notify_roles": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Default:  "",
-			},
-			"notify_details": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Default:  "",
-			},
-			"last_reviewed_date": {
-				Type:        schema.TypeString,
-				Description: "Last reviewed date for the group",
-				Optional:    true,
-			},
-			"principal_domain_filter": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Default:  "",
-			},
-		},
+		Schema:      dataSourceGroupSchema(),
 	}
 }
 
-func dataSourceGroupRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func dataSourceGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	zmsClient := meta.(client.ZmsClient)
 
 	domainName := d.Get("domain").(string)
 	groupName := d.Get("name").(string)
 	fullResourceName := domainName + GROUP_SEPARATOR + groupName
 
-	group, err := zmsClient.GetGroup(domainName, groupName)
+	group, err := zmsClient.GetGroupWithPendingMembers(ctx, domainName, groupName)
 	switch v := err.(type) {
 	case rdl.ResourceError:
 		if v.Code == 404 {
@@ -151,11 +36,15 @@ func dataSourceGroupRead(_ context.Context, d *schema.ResourceData, meta interfa
 	}
 	d.SetId(fullResourceName)
 
-	if len(group.GroupMembers) > 0 {
-		if err = d.Set("member", flattenGroupMembers(group.GroupMembers)); err != nil {
+	groupMembers, pendingMembers := splitPendingGroupMembers(group.GroupMembers, stringSet{})
+	if len(groupMembers) > 0 {
+		if err = d.Set("member", flattenGroupMembers(groupMembers)); err != nil {
 			return diag.FromErr(err)
 		}
 	}
+	if err = d.Set("pending_member", flattenPendingGroupMembers(pendingMembers)); err != nil {
+		return diag.FromErr(err)
+	}
 
 	if len(group.Tags) > 0 {
 		if err = d.Set("tags", flattenTag(group.Tags)); err != nil {
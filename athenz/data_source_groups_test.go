@@ -0,0 +1,66 @@
+package athenz
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AthenZ/athenz/clients/go/zms"
+	"github.com/AthenZ/terraform-provider-athenz/client"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ast "gotest.tools/assert"
+)
+
+func TestDataSourceGroupsRead(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	clientMock := client.NewMockZmsClient(mockCtrl)
+	members := true
+	approved := true
+	pending := false
+	selfServe := true
+	clientMock.EXPECT().GetGroups(gomock.Any(), "sports", &members, "env", "prod").Return(&zms.Groups{
+		List: []*zms.Group{
+			{
+				Name: "sports:group.dev",
+				GroupMembers: []*zms.GroupMember{
+					{MemberName: "user.alice", Approved: &approved},
+					{MemberName: "user.bob", Approved: &pending, PendingState: "ADD"},
+				},
+				SelfServe: &selfServe,
+			},
+			{Name: "sports:group.ops"},
+		},
+	}, nil)
+
+	d := schema.TestResourceDataRaw(t, DataSourceGroups().Schema, map[string]interface{}{
+		"domain":    "sports",
+		"tag_key":   "env",
+		"tag_value": "prod",
+	})
+	diags := dataSourceGroupsRead(context.Background(), d, clientMock)
+	ast.Assert(t, !diags.HasError(), diags)
+	ast.Equal(t, d.Id(), "sports_env_prod")
+
+	groups := map[string]map[string]interface{}{}
+	for _, group := range d.Get("groups").(*schema.Set).List() {
+		groups[group.(map[string]interface{})["name"].(string)] = group.(map[string]interface{})
+	}
+	ast.Equal(t, len(groups), 2)
+	ast.Equal(t, groups["sports:group.dev"]["domain"], "sports")
+	ast.Equal(t, groups["sports:group.dev"]["self_serve"], true)
+	ast.Equal(t, groups["sports:group.dev"]["member"].(*schema.Set).Len(), 1)
+	ast.Equal(t, groups["sports:group.dev"]["pending_member"].(*schema.Set).Len(), 1)
+	ast.Equal(t, groups["sports:group.ops"]["member"].(*schema.Set).Len(), 0)
+}
+
+func TestDataSourceGroupsReadTagValueWithoutKey(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	clientMock := client.NewMockZmsClient(mockCtrl)
+	d := schema.TestResourceDataRaw(t, DataSourceGroups().Schema, map[string]interface{}{
+		"domain":    "sports",
+		"tag_value": "prod",
+	})
+	diags := dataSourceGroupsRead(context.Background(), d, clientMock)
+	ast.Assert(t, diags.HasError())
+	ast.Equal(t, diags[0].Summary, "in order to input tag_value, tag_key must be provided")
+}
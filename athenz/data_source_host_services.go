@@ -0,0 +1,62 @@
+package athenz
+
+import (
+	"context"
+	"sort"
+
+	"github.com/AthenZ/terraform-provider-athenz/client"
+	"github.com/ardielle/ardielle-go/rdl"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceHostServices lists the services of all the domains a host is
+// registered on.
+func DataSourceHostServices() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceHostServicesRead,
+		Schema: map[string]*schema.Schema{
+			"host": {
+				Type:        schema.TypeString,
+				Description: "Name of the host",
+				Required:    true,
+			},
+			"names": {
+				Type:        schema.TypeList,
+				Description: "Full names of the services the host is registered on",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceHostServicesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zmsClient := meta.(client.ZmsClient)
+	host := d.Get("host").(string)
+	hostServices, err := zmsClient.GetHostServices(ctx, host)
+	switch v := err.(type) {
+	case rdl.ResourceError:
+		// ZMS does not know hosts which are not registered on any service
+		if v.Code != 404 {
+			return diag.Errorf("error retrieving Athenz Services of host %s: %s", host, v)
+		}
+	case rdl.Any:
+		return diag.FromErr(err)
+	}
+	if err = d.Set("names", hostServiceNames(hostServices)); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(host)
+	return nil
+}
+
+func hostServiceNames(hostServices *client.HostServices) []string {
+	names := make([]string, 0)
+	if hostServices == nil {
+		return names
+	}
+	names = append(names, hostServices.Names...)
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,42 @@
+package athenz
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AthenZ/terraform-provider-athenz/client"
+	"github.com/ardielle/ardielle-go/rdl"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ast "gotest.tools/assert"
+)
+
+func TestDataSourceHostServicesRead(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	clientMock := client.NewMockZmsClient(mockCtrl)
+	clientMock.EXPECT().GetHostServices(gomock.Any(), "host1.example.com").Return(&client.HostServices{
+		Host:  "host1.example.com",
+		Names: []string{"some_domain.api", "other_domain.backend"},
+	}, nil)
+
+	d := schema.TestResourceDataRaw(t, DataSourceHostServices().Schema, map[string]interface{}{
+		"host": "host1.example.com",
+	})
+	diags := dataSourceHostServicesRead(context.Background(), d, clientMock)
+	ast.Assert(t, !diags.HasError(), diags)
+	ast.DeepEqual(t, d.Get("names"), []interface{}{"other_domain.backend", "some_domain.api"})
+	ast.Equal(t, d.Id(), "host1.example.com")
+}
+
+func TestDataSourceHostServicesReadUnknownHost(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	clientMock := client.NewMockZmsClient(mockCtrl)
+	clientMock.EXPECT().GetHostServices(gomock.Any(), "host2.example.com").Return(nil, rdl.ResourceError{Code: 404, Message: "unknown host"})
+
+	d := schema.TestResourceDataRaw(t, DataSourceHostServices().Schema, map[string]interface{}{
+		"host": "host2.example.com",
+	})
+	diags := dataSourceHostServicesRead(context.Background(), d, clientMock)
+	ast.Assert(t, !diags.HasError(), diags)
+	ast.DeepEqual(t, d.Get("names"), []interface{}{})
+}
@@ -0,0 +1,96 @@
+package athenz
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+
+	"github.com/AthenZ/terraform-provider-athenz/client"
+	"github.com/ardielle/ardielle-go/rdl"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourcePolicies() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePoliciesRead,
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"tag_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tag_value": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"include_assertions": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"include_non_active": {
+				Type:        schema.TypeBool,
+				Description: "Whether to return the non active versions of the policies as well",
+				Optional:    true,
+				Default:     false,
+			},
+			"policies": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: dataSourcePoliciesElemSchema(),
+				},
+			},
+		},
+	}
+}
+
+// dataSourcePoliciesElemSchema adds the version fields to the policy schema,
+// the same policy can be listed once per version with include_non_active.
+func dataSourcePoliciesElemSchema() map[string]*schema.Schema {
+	policySchema := dataSourcePolicySchema()
+	policySchema["version"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+	policySchema["active"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Computed: true,
+	}
+	return policySchema
+}
+
+func dataSourcePoliciesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zmsClient := meta.(client.ZmsClient)
+
+	dn := d.Get("domain").(string)
+	tagKey := d.Get("tag_key").(string)
+	tagValue := d.Get("tag_value").(string)
+	if tagValue != "" && tagKey == "" {
+		return diag.Errorf("in order to input tag_value, tag_key must be provided")
+	}
+	assertions := d.Get("include_assertions").(bool)
+	includeNonActive := d.Get("include_non_active").(bool)
+	policies, err := zmsClient.GetPolicies(ctx, dn, assertions, includeNonActive, tagKey, tagValue)
+	switch v := err.(type) {
+	case rdl.ResourceError:
+		if v.Code == 404 {
+			return diag.Errorf("athenz Policies %s not found, update your data source query", dn+"key: "+tagKey+", value: "+tagValue)
+		} else {
+			return diag.Errorf("error retrieving Athenz Policies: %s", v)
+		}
+	case rdl.Any:
+		return diag.FromErr(err)
+	}
+	fullResourceName := dn + "_" + tagKey + "_" + tagValue
+	d.SetId(fullResourceName)
+	if policies != nil && policies.List != nil {
+		if err = d.Set("policies", flattenPolicies(policies.List, dn)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package athenz
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AthenZ/athenz/clients/go/zms"
+	"github.com/AthenZ/terraform-provider-athenz/client"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ast "gotest.tools/assert"
+)
+
+func TestDataSourcePoliciesRead(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	clientMock := client.NewMockZmsClient(mockCtrl)
+	active := true
+	inactive := false
+	allow := zms.ALLOW
+	clientMock.EXPECT().GetPolicies(gomock.Any(), "sports", true, true, "", "").Return(&zms.Policies{
+		List: []*zms.Policy{
+			{
+				Name:    "sports:policy.readers",
+				Version: "0",
+				Active:  &active,
+				Assertions: []*zms.Assertion{
+					{Role: "sports:role.readers", Action: "read", Resource: "sports:data", Effect: &allow},
+				},
+			},
+			{Name: "sports:policy.readers", Version: "1", Active: &inactive},
+		},
+	}, nil)
+
+	d := schema.TestResourceDataRaw(t, DataSourcePolicies().Schema, map[string]interface{}{
+		"domain":             "sports",
+		"include_non_active": true,
+	})
+	diags := dataSourcePoliciesRead(context.Background(), d, clientMock)
+	ast.Assert(t, !diags.HasError(), diags)
+	ast.Equal(t, d.Id(), "sports__")
+
+	policies := map[string]map[string]interface{}{}
+	for _, policy := range d.Get("policies").(*schema.Set).List() {
+		policies[policy.(map[string]interface{})["version"].(string)] = policy.(map[string]interface{})
+	}
+	ast.Equal(t, len(policies), 2)
+	ast.Equal(t, policies["0"]["name"], "sports:policy.readers")
+	ast.Equal(t, policies["0"]["active"], true)
+	ast.Equal(t, policies["0"]["assertion"].(*schema.Set).Len(), 1)
+	ast.Equal(t, policies["1"]["active"], false)
+}
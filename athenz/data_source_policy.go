@@ -13,21 +13,25 @@ import (
 func DataSourcePolicy() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourcePolicyRead,
-		Schema: map[string]*schema.Schema{
-			"domain": {
-				Type:     schema.TypeString,
-				Required: true,
-			},
-			"name": {
-				Type:     schema.TypeString,
-				Required: true,
-			},
-			"assertion": dataSourceAssertionSchema(),
-			"tags": {
-				Type:     schema.TypeMap,
-				Optional: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-			},
+		Schema:      dataSourcePolicySchema(),
+	}
+}
+
+func dataSourcePolicySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"domain": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"assertion": dataSourceAssertionSchema(),
+		"tags": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
 		},
 	}
 }
@@ -37,7 +41,7 @@ func dataSourcePolicyRead(ctx context.Context, d *schema.ResourceData, meta inte
 	dn := d.Get("domain").(string)
 	pn := d.Get("name").(string)
 	fullResourceName := dn + POLICY_SEPARATOR + pn
-	policy, err := zmsClient.GetPolicy(dn, pn)
+	policy, err := zmsClient.GetPolicy(ctx, dn, pn)
 	switch v := err.(type) {
 	case rdl.ResourceError:
 		if v.Code == 404 {
@@ -56,7 +56,7 @@ func dataSourcePolicyVersionRead(ctx context.Context, d *schema.ResourceData, me
 	dn := d.Get("domain").(string)
 	pn := d.Get("name").(string)
 	fullResourceName := dn + POLICY_SEPARATOR + pn
-	policyVersionList, err := getAllPolicyVersions(zmsClient, dn, pn)
+	policyVersionList, err := getAllPolicyVersions(ctx, zmsClient, dn, pn)
 	switch v := err.(type) {
 	case rdl.ResourceError:
 		if v.Code == 404 {
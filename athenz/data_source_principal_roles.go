@@ -0,0 +1,213 @@
+package athenz
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/AthenZ/athenz/clients/go/zms"
+	"github.com/AthenZ/terraform-provider-athenz/client"
+	"github.com/ardielle/ardielle-go/rdl"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourcePrincipalRoles lists the roles a principal belongs to, directly,
+// through a group or through a delegated (trust) role.
+func DataSourcePrincipalRoles() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePrincipalRolesRead,
+		Schema: map[string]*schema.Schema{
+			"principal": {
+				Type:             schema.TypeString,
+				Description:      "Name of the principal, e.g. user.joe or sports.api",
+				Required:         true,
+				ValidateDiagFunc: validatePatternFunc(MEMBER_NAME),
+			},
+			"domain": {
+				Type:             schema.TypeString,
+				Description:      "Only return the roles of this domain",
+				Optional:         true,
+				ValidateDiagFunc: validatePatternFunc(DOMAIN_NAME),
+			},
+			"roles": {
+				Type:        schema.TypeList,
+				Description: "Roles the principal belongs to",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"domain": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"membership": {
+							Type:        schema.TypeString,
+							Description: "How the principal belongs to the role: direct, group or trust",
+							Computed:    true,
+						},
+						"group": {
+							Type:        schema.TypeString,
+							Description: "Full name of the group the principal reaches the role through",
+							Computed:    true,
+						},
+						"trust_role": {
+							Type:        schema.TypeString,
+							Description: "Full name of the delegated role the principal reaches the role through",
+							Computed:    true,
+						},
+						"expiration": {
+							Type:        schema.TypeString,
+							Description: "Expiration of the membership, the earliest of the role and group memberships",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"groups": {
+				Type:        schema.TypeList,
+				Description: "Groups the principal belongs to",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"domain": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"expiration": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePrincipalRolesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zmsClient := meta.(client.ZmsClient)
+	principal := d.Get("principal").(string)
+	dn := d.Get("domain").(string)
+
+	roles, err := zmsClient.GetPrincipalRoles(ctx, principal, dn, true)
+	switch v := err.(type) {
+	case rdl.ResourceError:
+		if v.Code == 404 {
+			return diag.Errorf("athenz principal %s not found, update your data source query", principal)
+		}
+		return diag.Errorf("error retrieving the roles of Athenz principal %s: %s", principal, v)
+	case rdl.Any:
+		return diag.FromErr(err)
+	}
+	// the groups of every domain are needed, a role can include a group of
+	// another domain
+	groups, err := zmsClient.GetPrincipalGroups(ctx, principal, "")
+	switch v := err.(type) {
+	case rdl.ResourceError:
+		if v.Code != 404 {
+			return diag.Errorf("error retrieving the groups of Athenz principal %s: %s", principal, v)
+		}
+	case rdl.Any:
+		return diag.FromErr(err)
+	}
+
+	var memberRoles []*zms.MemberRole
+	if roles != nil {
+		memberRoles = roles.MemberRoles
+	}
+	var memberGroups []*zms.GroupMember
+	if groups != nil {
+		memberGroups = groups.MemberGroups
+	}
+	if err = d.Set("roles", flattenPrincipalRoles(principal, memberRoles, memberGroups)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("groups", flattenPrincipalGroups(memberGroups)); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(principal + "_" + dn)
+	return nil
+}
+
+// flattenPrincipalRoles sets the membership path of every role. ZMS sets the
+// member name of a role reached through a group to the group name, and the
+// trust role name of a role reached through a delegated role.
+func flattenPrincipalRoles(principal string, memberRoles []*zms.MemberRole, memberGroups []*zms.GroupMember) []interface{} {
+	groupExpirations := make(map[string]*rdl.Timestamp, len(memberGroups))
+	for _, group := range memberGroups {
+		groupExpirations[principalGroupName(group)] = group.Expiration
+	}
+
+	sorted := make([]*zms.MemberRole, len(memberRoles))
+	copy(sorted, memberRoles)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].DomainName != sorted[j].DomainName {
+			return sorted[i].DomainName < sorted[j].DomainName
+		}
+		return sorted[i].RoleName < sorted[j].RoleName
+	})
+
+	roles := make([]interface{}, 0, len(sorted))
+	for _, memberRole := range sorted {
+		role := map[string]interface{}{
+			"domain":     string(memberRole.DomainName),
+			"name":       string(memberRole.RoleName),
+			"membership": MEMBERSHIP_DIRECT,
+			"group":      "",
+			"trust_role": "",
+			"expiration": timestampToString(memberRole.Expiration),
+		}
+		memberName := string(memberRole.MemberName)
+		switch {
+		case memberRole.TrustRoleName != "":
+			role["membership"] = MEMBERSHIP_TRUST
+			role["trust_role"] = string(memberRole.TrustRoleName)
+		case memberName != "" && memberName != principal:
+			role["membership"] = MEMBERSHIP_GROUP
+			role["group"] = memberName
+			role["expiration"] = timestampToString(earliestTimestamp(memberRole.Expiration, groupExpirations[memberName]))
+		}
+		roles = append(roles, role)
+	}
+	return roles
+}
+
+func flattenPrincipalGroups(memberGroups []*zms.GroupMember) []interface{} {
+	groups := make([]interface{}, 0, len(memberGroups))
+	for _, group := range memberGroups {
+		groups = append(groups, map[string]interface{}{
+			"domain":     string(group.DomainName),
+			"name":       string(group.GroupName),
+			"expiration": timestampToString(group.Expiration),
+		})
+	}
+	return groups
+}
+
+// principalGroupName returns the full name of a group, the group name of the
+// principal groups API may or may not include the domain.
+func principalGroupName(group *zms.GroupMember) string {
+	name := string(group.GroupName)
+	if strings.Contains(name, GROUP_SEPARATOR) {
+		return name
+	}
+	return string(group.DomainName) + GROUP_SEPARATOR + name
+}
+
+func earliestTimestamp(first, second *rdl.Timestamp) *rdl.Timestamp {
+	if first == nil {
+		return second
+	}
+	if second == nil || first.Time.Before(second.Time) {
+		return first
+	}
+	return second
+}
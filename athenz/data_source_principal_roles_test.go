@@ -0,0 +1,59 @@
+package athenz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/AthenZ/athenz/clients/go/zms"
+	"github.com/AthenZ/terraform-provider-athenz/client"
+	"github.com/ardielle/ardielle-go/rdl"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ast "gotest.tools/assert"
+)
+
+func TestDataSourcePrincipalRolesRead(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	clientMock := client.NewMockZmsClient(mockCtrl)
+	roleExpiration := rdl.TimestampFromEpoch(float64(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC).Unix()))
+	groupExpiration := rdl.TimestampFromEpoch(float64(time.Date(2029, 6, 1, 0, 0, 0, 0, time.UTC).Unix()))
+	clientMock.EXPECT().GetPrincipalRoles(gomock.Any(), "sports.api", "", true).Return(&zms.DomainRoleMember{
+		MemberName: "sports.api",
+		MemberRoles: []*zms.MemberRole{
+			{RoleName: "writers", DomainName: "weather", MemberName: "sports:group.dev", Expiration: &roleExpiration},
+			{RoleName: "readers", DomainName: "sports", MemberName: "sports.api", Expiration: &roleExpiration},
+			{RoleName: "readers", DomainName: "weather", TrustRoleName: "sys.auth:role.weather_readers"},
+		},
+	}, nil)
+	clientMock.EXPECT().GetPrincipalGroups(gomock.Any(), "sports.api", "").Return(&zms.DomainGroupMember{
+		MemberName: "sports.api",
+		MemberGroups: []*zms.GroupMember{
+			{GroupName: "dev", DomainName: "sports", Expiration: &groupExpiration},
+		},
+	}, nil)
+
+	d := schema.TestResourceDataRaw(t, DataSourcePrincipalRoles().Schema, map[string]interface{}{
+		"principal": "sports.api",
+	})
+	diags := dataSourcePrincipalRolesRead(context.Background(), d, clientMock)
+	ast.Assert(t, !diags.HasError(), diags)
+	ast.Equal(t, d.Get("roles.#"), 3)
+
+	ast.Equal(t, d.Get("roles.0.domain"), "sports")
+	ast.Equal(t, d.Get("roles.0.membership"), MEMBERSHIP_DIRECT)
+	ast.Equal(t, d.Get("roles.0.expiration"), "2030-01-01 00:00:00")
+
+	ast.Equal(t, d.Get("roles.1.name"), "readers")
+	ast.Equal(t, d.Get("roles.1.membership"), MEMBERSHIP_TRUST)
+	ast.Equal(t, d.Get("roles.1.trust_role"), "sys.auth:role.weather_readers")
+
+	// the group membership expires before the role membership
+	ast.Equal(t, d.Get("roles.2.name"), "writers")
+	ast.Equal(t, d.Get("roles.2.membership"), MEMBERSHIP_GROUP)
+	ast.Equal(t, d.Get("roles.2.group"), "sports:group.dev")
+	ast.Equal(t, d.Get("roles.2.expiration"), "2029-06-01 00:00:00")
+
+	ast.Equal(t, d.Get("groups.#"), 1)
+	ast.Equal(t, d.Get("groups.0.name"), "dev")
+}
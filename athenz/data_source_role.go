@@ -17,14 +17,14 @@ func DataSourceRole() *schema.Resource {
 	}
 }
 
-func dataSourceRoleRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func dataSourceRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	zmsClient := meta.(client.ZmsClient)
 
 	dn := d.Get("domain").(string)
 	rn := d.Get("name").(string)
 	fullResourceName := dn + ROLE_SEPARATOR + rn
 
-	role, err := zmsClient.GetRole(dn, rn)
+	role, err := zmsClient.GetRoleWithPendingMembers(ctx, dn, rn)
 
 	switch v := err.(type) {
 	case rdl.ResourceError:
@@ -38,11 +38,15 @@ func dataSourceRoleRead(_ context.Context, d *schema.ResourceData, meta interfac
 	}
 	d.SetId(fullResourceName)
 
-	if len(role.RoleMembers) > 0 {
-		if err = d.Set("member", flattenRoleMembers(role.RoleMembers)); err != nil {
+	roleMembers, pendingMembers := splitPendingRoleMembers(role.RoleMembers, stringSet{})
+	if len(roleMembers) > 0 {
+		if err = d.Set("member", flattenRoleMembers(roleMembers)); err != nil {
 			return diag.FromErr(err)
 		}
 	}
+	if err = d.Set("pending_member", flattenPendingRoleMembers(pendingMembers)); err != nil {
+		return diag.FromErr(err)
+	}
 	if len(role.Tags) > 0 {
 		if err = d.Set("tags", flattenTag(role.Tags)); err != nil {
 			return diag.FromErr(err)
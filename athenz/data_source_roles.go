@@ -52,7 +52,7 @@ func dataSourceRolesRead(ctx context.Context, d *schema.ResourceData, meta inter
 		return diag.Errorf("in order to input tag_value, tag_key must be provided")
 	}
 	members := d.Get("include_members").(bool)
-	roles, err := zmsClient.GetRoles(dn, &members, tagKey, tagValue)
+	roles, err := zmsClient.GetRoles(ctx, dn, &members, tagKey, tagValue)
 	switch v := err.(type) {
 	case rdl.ResourceError:
 		if v.Code == 404 {
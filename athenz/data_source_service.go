@@ -13,47 +13,86 @@ import (
 func dataSourceService() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceServiceRead,
-		Schema: map[string]*schema.Schema{
-			"domain": {
-				Type:     schema.TypeString,
-				Required: true,
-			},
-			"name": {
-				Type:     schema.TypeString,
-				Required: true,
-			},
-			"description": {
-				Type:        schema.TypeString,
-				Description: "A description of the service",
-				Optional:    true,
-			},
-			"public_keys": {
-				Type:       schema.TypeSet,
-				ConfigMode: schema.SchemaConfigModeAttr,
-				Optional:   true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"key_id": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"key_value": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
+		Schema:      dataSourceServiceSchema(),
+	}
+}
+
+func dataSourceServiceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"domain": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Description: "A description of the service",
+			Optional:    true,
+		},
+		"public_keys": {
+			Type:       schema.TypeSet,
+			ConfigMode: schema.SchemaConfigModeAttr,
+			Optional:   true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"key_id": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"key_value": {
+						Type:     schema.TypeString,
+						Required: true,
 					},
 				},
 			},
-			"hosts": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-			},
-			"tags": {
-				Type:     schema.TypeMap,
-				Optional: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-			},
+		},
+		"hosts": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"tags": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"executable": {
+			Type:        schema.TypeString,
+			Description: "Path to the executable that runs the service",
+			Computed:    true,
+		},
+		"user": {
+			Type:        schema.TypeString,
+			Description: "Local (unix) user name the service runs as",
+			Computed:    true,
+		},
+		"group": {
+			Type:        schema.TypeString,
+			Description: "Local (unix) group name the service runs as",
+			Computed:    true,
+		},
+		"provider_endpoint": {
+			Type:        schema.TypeString,
+			Description: "Callback endpoint of the service when it is a provider",
+			Computed:    true,
+		},
+		"x509_cert_signer_key_id": {
+			Type:        schema.TypeString,
+			Description: "Key id of the signer of the x509 certificates of the service",
+			Computed:    true,
+		},
+		"ssh_cert_signer_key_id": {
+			Type:        schema.TypeString,
+			Description: "Key id of the signer of the ssh certificates of the service",
+			Computed:    true,
+		},
+		"client_id": {
+			Type:        schema.TypeString,
+			Description: "OAuth2 client id of the service",
+			Computed:    true,
 		},
 	}
 }
@@ -66,7 +105,7 @@ func dataSourceServiceRead(ctx context.Context, d *schema.ResourceData, meta int
 	shortServiceName := getShortName(domainName, serviceName, SERVICE_SEPARATOR)
 	fullResourceName := domainName + SERVICE_SEPARATOR + shortServiceName
 
-	service, err := client.GetServiceIdentity(domainName, shortServiceName)
+	service, err := client.GetServiceIdentity(ctx, domainName, shortServiceName)
 	switch v := err.(type) {
 	case rdl.ResourceError:
 		if v.Code == 404 {
@@ -94,6 +133,20 @@ func dataSourceServiceRead(ctx context.Context, d *schema.ResourceData, meta int
 			return diag.FromErr(err)
 		}
 	}
+	if err = d.Set("executable", service.Executable); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("user", service.User); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("group", service.Group); err != nil {
+		return diag.FromErr(err)
+	}
+	for _, field := range serviceSystemMetaFields {
+		if err = d.Set(field.name, field.service(service)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 
 	return nil
 }
@@ -0,0 +1,243 @@
+package athenz
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/AthenZ/athenz/clients/go/zms"
+	"github.com/AthenZ/terraform-provider-athenz/client"
+	"github.com/ardielle/ardielle-go/rdl"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceServiceTemplates() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceServiceTemplatesRead,
+		Schema: map[string]*schema.Schema{
+			"template": {
+				Type:             schema.TypeString,
+				Description:      "Name of a template to expand into the roles, policies and services it creates",
+				Optional:         true,
+				ValidateDiagFunc: validatePatternFunc(SIMPLE_NAME),
+			},
+			"domain": {
+				Type:             schema.TypeString,
+				Description:      "Domain name replacing the _domain_ keyword of the expanded template",
+				Optional:         true,
+				ValidateDiagFunc: validatePatternFunc(DOMAIN_NAME),
+			},
+			"params": {
+				Type:        schema.TypeMap,
+				Description: "Values replacing the other keywords of the expanded template, e.g. service",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"templates": {
+				Type:        schema.TypeList,
+				Description: "Solution templates available on the server",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"current_version": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"latest_version": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"keywords": {
+							Type:        schema.TypeList,
+							Description: "Keywords that must be given as params when the template is applied",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"roles": {
+				Type:        schema.TypeList,
+				Description: "Roles created by the expanded template",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: dataSourceRoleSchema(),
+				},
+			},
+			"policies": {
+				Type:        schema.TypeList,
+				Description: "Policies created by the expanded template",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"assertion": dataSourceAssertionSchema(),
+					},
+				},
+			},
+			"services": {
+				Type:        schema.TypeList,
+				Description: "Services created by the expanded template",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"provider_endpoint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hosts": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceServiceTemplatesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zmsClient := meta.(client.ZmsClient)
+
+	templates, err := zmsClient.GetServerTemplateDetailsList(ctx)
+	if err != nil {
+		return diag.Errorf("error retrieving Athenz server templates: %s", err)
+	}
+	if err = d.Set("templates", flattenTemplateMetaData(templates.MetaData)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	templateName := d.Get("template").(string)
+	if templateName == "" {
+		d.SetId("templates")
+		return nil
+	}
+	template, err := zmsClient.GetTemplate(ctx, templateName)
+	switch v := err.(type) {
+	case rdl.ResourceError:
+		if v.Code == 404 {
+			return diag.Errorf("athenz Template %s not found, update your data source query", templateName)
+		}
+		return diag.Errorf("error retrieving Athenz Template: %s", v)
+	case rdl.Any:
+		return diag.FromErr(err)
+	}
+	domainName := d.Get("domain").(string)
+	template, err = expandTemplateKeywords(template, domainName, d.Get("params").(map[string]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if domainName == "" {
+		domainName = TEMPLATE_DOMAIN_KEYWORD
+	}
+	if err = d.Set("roles", flattenRoles(template.Roles, domainName)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("policies", flattenTemplatePolicies(template.Policies)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("services", flattenTemplateServices(template.Services)); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("templates" + RESOURCE_SEPARATOR + templateName)
+	return nil
+}
+
+func flattenTemplateMetaData(list []*zms.TemplateMetaData) []interface{} {
+	templates := make([]interface{}, 0, len(list))
+	for _, metaData := range list {
+		keywords := make([]interface{}, 0)
+		for _, keyword := range strings.Split(metaData.KeywordsToReplace, ",") {
+			if keyword = strings.TrimSpace(keyword); keyword != "" {
+				keywords = append(keywords, keyword)
+			}
+		}
+		templates = append(templates, map[string]interface{}{
+			"name":            metaData.TemplateName,
+			"description":     metaData.Description,
+			"current_version": int32Value(metaData.CurrentVersion),
+			"latest_version":  int32Value(metaData.LatestVersion),
+			"keywords":        keywords,
+		})
+	}
+	return templates
+}
+
+func flattenTemplatePolicies(list []*zms.Policy) []interface{} {
+	policies := make([]interface{}, 0, len(list))
+	for _, policy := range list {
+		policies = append(policies, map[string]interface{}{
+			"name":      string(policy.Name),
+			"assertion": flattenPolicyAssertion(policy.Assertions),
+		})
+	}
+	return policies
+}
+
+func flattenTemplateServices(list []*zms.ServiceIdentity) []interface{} {
+	services := make([]interface{}, 0, len(list))
+	for _, service := range list {
+		services = append(services, map[string]interface{}{
+			"name":              string(service.Name),
+			"description":       service.Description,
+			"provider_endpoint": service.ProviderEndpoint,
+			"hosts":             service.Hosts,
+		})
+	}
+	return services
+}
+
+// expandTemplateKeywords replaces the _domain_ keyword and the _<param>_
+// keywords of a server template with the given values, the same way ZMS does
+// when the template is applied to a domain.
+func expandTemplateKeywords(template *zms.Template, domainName string, params map[string]interface{}) (*zms.Template, error) {
+	var replacements []string
+	if domainName != "" {
+		replacements = append(replacements, TEMPLATE_DOMAIN_KEYWORD, domainName)
+	}
+	for name, value := range params {
+		replacements = append(replacements, "_"+name+"_", value.(string))
+	}
+	if len(replacements) == 0 {
+		return template, nil
+	}
+	for i := 1; i < len(replacements); i += 2 {
+		// the values are substituted into the JSON document
+		escaped, err := json.Marshal(replacements[i])
+		if err != nil {
+			return nil, err
+		}
+		replacements[i] = string(escaped[1 : len(escaped)-1])
+	}
+	data, err := json.Marshal(template)
+	if err != nil {
+		return nil, err
+	}
+	data = []byte(strings.NewReplacer(replacements...).Replace(string(data)))
+	var expanded zms.Template
+	if err = json.Unmarshal(data, &expanded); err != nil {
+		return nil, err
+	}
+	return &expanded, nil
+}
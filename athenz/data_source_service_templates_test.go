@@ -0,0 +1,115 @@
+package athenz
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"testing"
+
+	"github.com/AthenZ/athenz/clients/go/zms"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	ast "gotest.tools/assert"
+)
+
+func TestAccServiceTemplatesDataSource(t *testing.T) {
+	if v := os.Getenv("TF_ACC"); v != "1" && v != "true" {
+		log.Printf("TF_ACC must be set for acceptance tests, value is: %s", v)
+		return
+	}
+	dataSourceName := "data.athenz_service_templates.templatesTest"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceTemplatesDataSourceConfig("sys.auth", "api"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "templates.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "roles.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "policies.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccServiceTemplatesDataSourceConfig(domain, service string) string {
+	return fmt.Sprintf(`
+data "athenz_service_templates" "templatesTest" {
+  template = "zts_instance_provider"
+  domain = "%s"
+  params = {
+    service = "%s"
+  }
+}
+`, domain, service)
+}
+
+func getTestTemplate() *zms.Template {
+	effect := zms.ALLOW
+	return &zms.Template{
+		Roles: []*zms.Role{
+			{Name: "_domain_:role._service_.launcher", Trust: "sys.auth"},
+		},
+		Policies: []*zms.Policy{
+			{
+				Name: "_domain_:policy._service_.launcher",
+				Assertions: []*zms.Assertion{
+					{Role: "_domain_:role._service_.launcher", Resource: "_domain_:service._service_", Action: "launch", Effect: &effect},
+				},
+			},
+		},
+		Services: []*zms.ServiceIdentity{
+			{Name: "_domain_._service_", Description: "provider _service_"},
+		},
+	}
+}
+
+func TestExpandTemplateKeywords(t *testing.T) {
+	expanded, err := expandTemplateKeywords(getTestTemplate(), "some_domain", map[string]interface{}{"service": "api"})
+	ast.NilError(t, err)
+	ast.Equal(t, string(expanded.Roles[0].Name), "some_domain:role.api.launcher")
+	ast.Equal(t, string(expanded.Policies[0].Name), "some_domain:policy.api.launcher")
+	ast.Equal(t, expanded.Policies[0].Assertions[0].Resource, "some_domain:service.api")
+	ast.Equal(t, string(expanded.Services[0].Name), "some_domain.api")
+	ast.Equal(t, expanded.Services[0].Description, "provider api")
+
+	unchanged, err := expandTemplateKeywords(getTestTemplate(), "", nil)
+	ast.NilError(t, err)
+	ast.Equal(t, string(unchanged.Roles[0].Name), "_domain_:role._service_.launcher")
+
+	// values are escaped, an invalid name is reported by the zms validation
+	_, err = expandTemplateKeywords(getTestTemplate(), "", map[string]interface{}{"service": `a"b`})
+	ast.ErrorContains(t, err, "does not contain a valid")
+}
+
+func TestFlattenTemplatePolicies(t *testing.T) {
+	expected := []interface{}{
+		map[string]interface{}{
+			"name": "_domain_:policy._service_.launcher",
+			"assertion": []interface{}{
+				map[string]interface{}{
+					"role":           "_service_.launcher",
+					"resource":       "_domain_:service._service_",
+					"action":         "launch",
+					"effect":         "ALLOW",
+					"case_sensitive": false,
+				},
+			},
+		},
+	}
+	ast.DeepEqual(t, flattenTemplatePolicies(getTestTemplate().Policies), expected)
+}
+
+func TestFlattenTemplateMetaData(t *testing.T) {
+	version := int32(2)
+	list := []*zms.TemplateMetaData{
+		{TemplateName: "aws", Description: "AWS access", CurrentVersion: &version, LatestVersion: &version, KeywordsToReplace: "_account_, _service_"},
+		{TemplateName: "admin"},
+	}
+	expected := []interface{}{
+		map[string]interface{}{"name": "aws", "description": "AWS access", "current_version": 2, "latest_version": 2, "keywords": []interface{}{"_account_", "_service_"}},
+		map[string]interface{}{"name": "admin", "description": "", "current_version": 0, "latest_version": 0, "keywords": []interface{}{}},
+	}
+	ast.DeepEqual(t, flattenTemplateMetaData(list), expected)
+}
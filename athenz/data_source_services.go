@@ -0,0 +1,80 @@
+package athenz
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+
+	"github.com/AthenZ/terraform-provider-athenz/client"
+	"github.com/ardielle/ardielle-go/rdl"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceServices() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceServicesRead,
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"tag_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tag_value": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"include_public_keys": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"include_hosts": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"services": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: dataSourceServiceSchema(),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceServicesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zmsClient := meta.(client.ZmsClient)
+
+	dn := d.Get("domain").(string)
+	tagKey := d.Get("tag_key").(string)
+	tagValue := d.Get("tag_value").(string)
+	if tagValue != "" && tagKey == "" {
+		return diag.Errorf("in order to input tag_value, tag_key must be provided")
+	}
+	publicKeys := d.Get("include_public_keys").(bool)
+	hosts := d.Get("include_hosts").(bool)
+	services, err := zmsClient.GetServiceIdentities(ctx, dn, publicKeys, hosts, tagKey, tagValue)
+	switch v := err.(type) {
+	case rdl.ResourceError:
+		if v.Code == 404 {
+			return diag.Errorf("athenz Services %s not found, update your data source query", dn+"key: "+tagKey+", value: "+tagValue)
+		} else {
+			return diag.Errorf("error retrieving Athenz Services: %s", v)
+		}
+	case rdl.Any:
+		return diag.FromErr(err)
+	}
+	fullResourceName := dn + "_" + tagKey + "_" + tagValue
+	d.SetId(fullResourceName)
+	if services != nil && services.List != nil {
+		if err = d.Set("services", flattenServices(services.List, dn)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package athenz
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AthenZ/athenz/clients/go/zms"
+	"github.com/AthenZ/terraform-provider-athenz/client"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ast "gotest.tools/assert"
+)
+
+func TestDataSourceServicesRead(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	clientMock := client.NewMockZmsClient(mockCtrl)
+	clientMock.EXPECT().GetServiceIdentities(gomock.Any(), "sports", false, true, "env", "").Return(&zms.ServiceIdentities{
+		List: []*zms.ServiceIdentity{
+			{
+				Name:             "sports.api",
+				Description:      "api service",
+				Hosts:            []string{"host1.example.com", "host2.example.com"},
+				ProviderEndpoint: "https://api.example.com",
+			},
+		},
+	}, nil)
+
+	d := schema.TestResourceDataRaw(t, DataSourceServices().Schema, map[string]interface{}{
+		"domain":              "sports",
+		"tag_key":             "env",
+		"include_public_keys": false,
+	})
+	diags := dataSourceServicesRead(context.Background(), d, clientMock)
+	ast.Assert(t, !diags.HasError(), diags)
+	ast.Equal(t, d.Id(), "sports_env_")
+
+	services := d.Get("services").(*schema.Set).List()
+	ast.Equal(t, len(services), 1)
+	service := services[0].(map[string]interface{})
+	ast.Equal(t, service["name"], "sports.api")
+	ast.Equal(t, service["description"], "api service")
+	ast.Equal(t, service["hosts"].(*schema.Set).Len(), 2)
+	ast.Equal(t, service["provider_endpoint"], "https://api.example.com")
+}
@@ -4,34 +4,46 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/AthenZ/terraform-provider-athenz/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// Version is the provider release, set from main at build time and reported
+// in the User-Agent of ZMS requests.
+var Version = "dev"
+
 // Provider returns a terraform.ResourceProvider.
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"zms_url": {
 				Type:        schema.TypeString,
 				Description: fmt.Sprintf("Athenz API URL"),
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("ATHENZ_ZMS_URL", nil),
 			},
+			"zms_urls": {
+				Type:        schema.TypeList,
+				Description: fmt.Sprintf("Ordered list of Athenz API URLs, requests fail over to the next one when an endpoint is unavailable"),
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.IsURLWithHTTPorHTTPS},
+			},
 			"cert": {
 				Type:        schema.TypeString,
 				Description: fmt.Sprintf("Athenz client certificate"),
 				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("ATHENZ_CERT", os.Getenv("HOME")+"/.athenz/cert"),
+				DefaultFunc: schema.EnvDefaultFunc("ATHENZ_CERT", ""),
 			},
 			"key": {
 				Type:        schema.TypeString,
 				Description: fmt.Sprintf("Athenz client key"),
 				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("ATHENZ_KEY", os.Getenv("HOME")+"/.athenz/key"),
+				DefaultFunc: schema.EnvDefaultFunc("ATHENZ_KEY", ""),
 			},
 			"cacert": {
 				Type:        schema.TypeString,
@@ -39,6 +51,72 @@ func Provider() *schema.Provider {
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("ATHENZ_CA_CERT", ""),
 			},
+			"cert_pem": {
+				Type:        schema.TypeString,
+				Description: fmt.Sprintf("Athenz client certificate in PEM format"),
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("ATHENZ_CERT_PEM", ""),
+			},
+			"key_pem": {
+				Type:        schema.TypeString,
+				Description: fmt.Sprintf("Athenz client key in PEM format"),
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("ATHENZ_KEY_PEM", ""),
+			},
+			"cacert_pem": {
+				Type:        schema.TypeString,
+				Description: fmt.Sprintf("CA Certificate in PEM format"),
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("ATHENZ_CA_CERT_PEM", ""),
+			},
+			"auth_mode": {
+				Type:         schema.TypeString,
+				Description:  fmt.Sprintf("Authentication mode for ZMS requests: mtls or token"),
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ATHENZ_AUTH_MODE", client.AuthModeMTLS),
+				ValidateFunc: validation.StringInSlice([]string{client.AuthModeMTLS, client.AuthModeToken}, false),
+			},
+			"access_token": {
+				Type:        schema.TypeString,
+				Description: fmt.Sprintf("Athenz access token sent as Bearer credentials in token auth mode"),
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("ATHENZ_ACCESS_TOKEN", ""),
+			},
+			"access_token_file": {
+				Type:        schema.TypeString,
+				Description: fmt.Sprintf("File containing the Athenz access token in token auth mode"),
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ATHENZ_ACCESS_TOKEN_FILE", ""),
+			},
+			"zts_url": {
+				Type:        schema.TypeString,
+				Description: fmt.Sprintf("Athenz ZTS API URL used to obtain access tokens in token auth mode"),
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ATHENZ_ZTS_URL", ""),
+			},
+			"access_token_scope": {
+				Type:        schema.TypeString,
+				Description: fmt.Sprintf("Scope of the access tokens requested from ZTS"),
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ATHENZ_ACCESS_TOKEN_SCOPE", ""),
+			},
+			"oidc_token": {
+				Type:        schema.TypeString,
+				Description: fmt.Sprintf("OIDC id token exchanged for an access token at ZTS"),
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("ATHENZ_OIDC_TOKEN", ""),
+			},
+			"oidc_token_file": {
+				Type:        schema.TypeString,
+				Description: fmt.Sprintf("File containing the OIDC id token exchanged for an access token at ZTS"),
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ATHENZ_OIDC_TOKEN_FILE", ""),
+			},
 			"disable_resource_ownership": {
 				Type:        schema.TypeBool,
 				Description: fmt.Sprintf("Disable resource ownership feature"),
@@ -63,49 +141,235 @@ func Provider() *schema.Provider {
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("ATHENZ_GROUP_META_RESOURCE_STATE", client.StateCreateIfNecessary),
 			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Description:  fmt.Sprintf("Maximum number of retries for a failed ZMS request"),
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ATHENZ_MAX_RETRIES", client.DefaultMaxRetries),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"retry_max_wait": {
+				Type:         schema.TypeInt,
+				Description:  fmt.Sprintf("Maximum number of seconds to wait between retries"),
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ATHENZ_RETRY_MAX_WAIT", int(client.DefaultRetryMaxWait/time.Second)),
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"retry_on_status": {
+				Type:        schema.TypeList,
+				Description: fmt.Sprintf("HTTP status codes that are retried"),
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validation.IntBetween(400, 599),
+				},
+			},
+			"requests_per_second": {
+				Type:         schema.TypeFloat,
+				Description:  fmt.Sprintf("Maximum number of ZMS requests per second, 0 means unlimited"),
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ATHENZ_REQUESTS_PER_SECOND", 0),
+				ValidateFunc: validation.FloatAtLeast(0),
+			},
+			"max_concurrent_requests": {
+				Type:         schema.TypeInt,
+				Description:  fmt.Sprintf("Maximum number of concurrent ZMS requests, 0 means unlimited"),
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ATHENZ_MAX_CONCURRENT_REQUESTS", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"connection_pool_size": {
+				Type:         schema.TypeInt,
+				Description:  fmt.Sprintf("Maximum number of idle connections kept open to ZMS"),
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ATHENZ_CONNECTION_POOL_SIZE", client.DefaultConnectionPoolSize),
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"request_timeout": {
+				Type:         schema.TypeInt,
+				Description:  fmt.Sprintf("Timeout in seconds for a single ZMS request, 0 means no timeout"),
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ATHENZ_REQUEST_TIMEOUT", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"tls_handshake_timeout": {
+				Type:         schema.TypeInt,
+				Description:  fmt.Sprintf("Timeout in seconds for the TLS handshake with ZMS"),
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ATHENZ_TLS_HANDSHAKE_TIMEOUT", int(client.DefaultTLSHandshakeTimeout/time.Second)),
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"cert_reload_interval": {
+				Type:         schema.TypeInt,
+				Description:  fmt.Sprintf("Interval in seconds to check the client certificate files for changes, 0 disables reloading"),
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ATHENZ_CERT_RELOAD_INTERVAL", int(client.DefaultCertReloadInterval/time.Second)),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"proxy_url": {
+				Type:        schema.TypeString,
+				Description: fmt.Sprintf("Proxy URL used for ZMS and ZTS requests, overrides HTTP_PROXY and HTTPS_PROXY"),
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ATHENZ_PROXY_URL", ""),
+			},
+			"no_proxy": {
+				Type:        schema.TypeString,
+				Description: fmt.Sprintf("Comma separated list of hosts that bypass the proxy, overrides NO_PROXY"),
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ATHENZ_NO_PROXY", ""),
+			},
+			"headers": {
+				Type:        schema.TypeMap,
+				Description: fmt.Sprintf("Additional HTTP headers sent with every ZMS request"),
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
 			"athenz_role":               DataSourceRole(),
 			"athenz_group":              DataSourceGroup(),
+			"athenz_groups":             DataSourceGroups(),
 			"athenz_policy":             DataSourcePolicy(),
+			"athenz_policies":           DataSourcePolicies(),
 			"athenz_policy_version":     DataSourcePolicyVersion(),
 			"athenz_service":            dataSourceService(),
+			"athenz_services":           DataSourceServices(),
 			"athenz_domain":             DataSourceDomain(),
+			"athenz_domains":            DataSourceDomains(),
 			"athenz_all_domain_details": DataSourceAllDomainDetails(),
 			"athenz_roles":              DataSourceRoles(),
+			"athenz_service_templates":  DataSourceServiceTemplates(),
+			"athenz_entity":             DataSourceEntity(),
+			"athenz_entities":           DataSourceEntities(),
+			"athenz_domain_quota":       DataSourceDomainQuota(),
+			"athenz_host_services":      DataSourceHostServices(),
+			"athenz_dependent_domains":  DataSourceDependentDomains(),
+			"athenz_principal_roles":    DataSourcePrincipalRoles(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"athenz_role":                     ResourceRole(),
-			"athenz_role_members":             ResourceRoleMembers(),
-			"athenz_self_serve_role_members":  ResourceSelfServeRoleMembers(),
-			"athenz_role_meta":                ResourceRoleMeta(),
-			"athenz_group":                    ResourceGroup(),
-			"athenz_group_members":            ResourceGroupMembers(),
-			"athenz_self_serve_group_members": ResourceSelfServeGroupMembers(),
-			"athenz_group_meta":               ResourceGroupMeta(),
-			"athenz_policy":                   ResourcePolicy(),
-			"athenz_policy_version":           ResourcePolicyVersion(),
-			"athenz_service":                  ResourceService(),
-			"athenz_sub_domain":               ResourceSubDomain(),
-			"athenz_user_domain":              ResourceUserDomain(),
-			"athenz_top_level_domain":         ResourceTopLevelDomain(),
-			"athenz_domain_meta":              ResourceDomainMeta(),
+			"athenz_role":                        ResourceRole(),
+			"athenz_role_members":                ResourceRoleMembers(),
+			"athenz_role_membership":             ResourceRoleMembership(),
+			"athenz_role_membership_decision":    ResourceRoleMembershipDecision(),
+			"athenz_role_review":                 ResourceRoleReview(),
+			"athenz_self_serve_role_members":     ResourceSelfServeRoleMembers(),
+			"athenz_role_meta":                   ResourceRoleMeta(),
+			"athenz_group":                       ResourceGroup(),
+			"athenz_group_members":               ResourceGroupMembers(),
+			"athenz_group_membership":            ResourceGroupMembership(),
+			"athenz_self_serve_group_members":    ResourceSelfServeGroupMembers(),
+			"athenz_group_meta":                  ResourceGroupMeta(),
+			"athenz_policy":                      ResourcePolicy(),
+			"athenz_policy_version":              ResourcePolicyVersion(),
+			"athenz_service":                     ResourceService(),
+			"athenz_service_host":                ResourceServiceHost(),
+			"athenz_service_public_key":          ResourceServicePublicKey(),
+			"athenz_sub_domain":                  ResourceSubDomain(),
+			"athenz_user_domain":                 ResourceUserDomain(),
+			"athenz_top_level_domain":            ResourceTopLevelDomain(),
+			"athenz_domain_meta":                 ResourceDomainMeta(),
+			"athenz_domain_template":             ResourceDomainTemplate(),
+			"athenz_entity":                      ResourceEntity(),
+			"athenz_domain_quota":                ResourceDomainQuota(),
+			"athenz_domain_dependency":           ResourceDomainDependency(),
+			"athenz_tenancy":                     ResourceTenancy(),
+			"athenz_tenant_resource_group_roles": ResourceTenantResourceGroupRoles(),
 		},
-
-		ConfigureContextFunc: configProvider,
 	}
+	p.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		return configProvider(ctx, d, p.UserAgent("terraform-provider-athenz", Version))
+	}
+	return p
 }
 
-func configProvider(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+func configProvider(ctx context.Context, d *schema.ResourceData, userAgent string) (interface{}, diag.Diagnostics) {
+	cert := d.Get("cert").(string)
+	key := d.Get("key").(string)
+	caCert := d.Get("cacert").(string)
+	certPem := d.Get("cert_pem").(string)
+	keyPem := d.Get("key_pem").(string)
+	caCertPem := d.Get("cacert_pem").(string)
+	if (certPem == "") != (keyPem == "") {
+		return nil, diag.Errorf("cert_pem and key_pem must be configured together")
+	}
+	// the exclusive attributes are checked here rather than with ConflictsWith,
+	// their default values would otherwise conflict with every configuration
+	if cert != "" && certPem != "" {
+		return nil, diag.Errorf("only one of cert or cert_pem can be configured")
+	}
+	if key != "" && keyPem != "" {
+		return nil, diag.Errorf("only one of key or key_pem can be configured")
+	}
+	if caCert != "" && caCertPem != "" {
+		return nil, diag.Errorf("only one of cacert or cacert_pem can be configured")
+	}
+	accessTokenSources := 0
+	for _, name := range []string{"access_token", "access_token_file", "zts_url"} {
+		if d.Get(name).(string) != "" {
+			accessTokenSources++
+		}
+	}
+	if accessTokenSources > 1 {
+		return nil, diag.Errorf("only one of access_token, access_token_file or zts_url can be configured")
+	}
+	if d.Get("oidc_token").(string) != "" && d.Get("oidc_token_file").(string) != "" {
+		return nil, diag.Errorf("only one of oidc_token or oidc_token_file can be configured")
+	}
+	if certPem == "" {
+		if cert == "" {
+			cert = os.Getenv("HOME") + "/.athenz/cert"
+		}
+		if key == "" {
+			key = os.Getenv("HOME") + "/.athenz/key"
+		}
+	}
+	var urls []string
+	for _, url := range d.Get("zms_urls").([]interface{}) {
+		urls = append(urls, url.(string))
+	}
+	if len(urls) == 0 && d.Get("zms_url").(string) == "" {
+		return nil, diag.Errorf("one of zms_url or zms_urls must be configured")
+	}
 	zms := client.ZmsConfig{
 		Url:                    d.Get("zms_url").(string),
-		Cert:                   d.Get("cert").(string),
-		Key:                    d.Get("key").(string),
-		CaCert:                 d.Get("cacert").(string),
+		Urls:                   urls,
+		Cert:                   cert,
+		Key:                    key,
+		CaCert:                 caCert,
+		CertPem:                certPem,
+		KeyPem:                 keyPem,
+		CaCertPem:              caCertPem,
+		AuthMode:               d.Get("auth_mode").(string),
+		AccessToken:            d.Get("access_token").(string),
+		AccessTokenFile:        d.Get("access_token_file").(string),
+		ZtsUrl:                 d.Get("zts_url").(string),
+		AccessTokenScope:       d.Get("access_token_scope").(string),
+		OidcToken:              d.Get("oidc_token").(string),
+		OidcTokenFile:          d.Get("oidc_token_file").(string),
 		RoleMetaResourceState:  d.Get("role_meta_resource_state").(int),
 		GroupMetaResourceState: d.Get("group_meta_resource_state").(int),
+		MaxRetries:             d.Get("max_retries").(int),
+		RetryMaxWait:           time.Duration(d.Get("retry_max_wait").(int)) * time.Second,
+		RequestsPerSecond:      d.Get("requests_per_second").(float64),
+		MaxConcurrentRequests:  d.Get("max_concurrent_requests").(int),
+		ConnectionPoolSize:     d.Get("connection_pool_size").(int),
+		RequestTimeout:         time.Duration(d.Get("request_timeout").(int)) * time.Second,
+		TLSHandshakeTimeout:    time.Duration(d.Get("tls_handshake_timeout").(int)) * time.Second,
+		CertReloadInterval:     time.Duration(d.Get("cert_reload_interval").(int)) * time.Second,
+		ProxyUrl:               d.Get("proxy_url").(string),
+		NoProxy:                d.Get("no_proxy").(string),
+		UserAgent:              userAgent,
+	}
+	for _, status := range d.Get("retry_on_status").([]interface{}) {
+		zms.RetryOnStatus = append(zms.RetryOnStatus, status.(int))
+	}
+	if headers := d.Get("headers").(map[string]interface{}); len(headers) > 0 {
+		zms.Headers = make(map[string]string, len(headers))
+		for name, value := range headers {
+			zms.Headers[name] = value.(string)
+		}
 	}
 	// if resource ownership is not disabled, then load the resource owner
 	if !d.Get("disable_resource_ownership").(bool) {
@@ -0,0 +1,132 @@
+package athenz
+
+import (
+	"context"
+	"log"
+
+	"github.com/AthenZ/athenz/clients/go/zms"
+	"github.com/AthenZ/terraform-provider-athenz/client"
+	"github.com/ardielle/ardielle-go/rdl"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceDomainDependency records that a domain depends on a provider
+// service, ZMS then refuses to delete the domain until the dependency is
+// removed.
+func ResourceDomainDependency() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDomainDependencyCreate,
+		ReadContext:   resourceDomainDependencyRead,
+		DeleteContext: resourceDomainDependencyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:             schema.TypeString,
+				Description:      "Name of the dependent domain",
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validatePatternFunc(DOMAIN_NAME),
+			},
+			"service": {
+				Type:             schema.TypeString,
+				Description:      "Full name of the provider service the domain depends on, <provider_domain>.<service>",
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validatePatternFunc(SERVICE_NAME),
+			},
+			"audit_ref": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  AUDIT_REF,
+			},
+		},
+	}
+}
+
+func resourceDomainDependencyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zmsClient := meta.(client.ZmsClient)
+	dn := d.Get("domain").(string)
+	service := d.Get("service").(string)
+
+	services, err := zmsClient.GetDependentServiceList(ctx, dn)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if services != nil && containsServiceName(services.Names, service) {
+		return diag.Errorf("the dependency of the domain %s on the service %s already exists, use terraform import command", dn, service)
+	}
+	if err = zmsClient.PutDomainDependency(ctx, dn, service, d.Get("audit_ref").(string)); err != nil {
+		return diag.Errorf("error adding dependency of domain %s on service %s: %s", dn, service, err)
+	}
+	d.SetId(dn + DEPENDENCY_SEPARATOR + service)
+	return readAfterWrite(resourceDomainDependencyRead, ctx, d, meta)
+}
+
+func resourceDomainDependencyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zmsClient := meta.(client.ZmsClient)
+	dn, service, err := splitDomainDependencyId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	services, err := zmsClient.GetDependentServiceList(ctx, dn)
+	switch v := err.(type) {
+	case rdl.ResourceError:
+		if v.Code == 404 && !d.IsNewResource() {
+			log.Printf("[WARN] Athenz Domain %s not found, removing dependency %s from state", dn, d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error retrieving the dependencies of Athenz Domain %s: %s", dn, v)
+	case rdl.Any:
+		return diag.FromErr(err)
+	}
+	if services == nil || !containsServiceName(services.Names, service) {
+		if !d.IsNewResource() {
+			log.Printf("[WARN] Athenz Domain Dependency %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("the dependency of the domain %s on the service %s was not added", dn, service)
+	}
+
+	if err = d.Set("domain", dn); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("service", service); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceDomainDependencyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zmsClient := meta.(client.ZmsClient)
+	dn, service, err := splitDomainDependencyId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = zmsClient.DeleteDomainDependency(ctx, dn, service, d.Get("audit_ref").(string))
+
+	switch v := err.(type) {
+	case rdl.ResourceError:
+		if v.Code == 404 {
+			return nil
+		}
+		return diag.FromErr(err)
+	case rdl.Any:
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func containsServiceName(names []zms.EntityName, service string) bool {
+	for _, name := range names {
+		if string(name) == service {
+			return true
+		}
+	}
+	return false
+}
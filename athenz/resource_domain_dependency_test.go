@@ -0,0 +1,39 @@
+package athenz
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AthenZ/athenz/clients/go/zms"
+	"github.com/AthenZ/terraform-provider-athenz/client"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ast "gotest.tools/assert"
+)
+
+func TestDomainDependencyCreate(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	clientMock := client.NewMockZmsClient(mockCtrl)
+	gomock.InOrder(
+		clientMock.EXPECT().GetDependentServiceList(gomock.Any(), "sports").Return(nil, nil),
+		clientMock.EXPECT().PutDomainDependency(gomock.Any(), "sports", "sys.storage.api", AUDIT_REF).Return(nil),
+		clientMock.EXPECT().GetDependentServiceList(gomock.Any(), "sports").Return(&zms.ServiceIdentityList{Names: []zms.EntityName{"sys.storage.api"}}, nil).Times(2),
+	)
+
+	d := schema.TestResourceDataRaw(t, ResourceDomainDependency().Schema, map[string]interface{}{
+		"domain":  "sports",
+		"service": "sys.storage.api",
+	})
+	diags := resourceDomainDependencyCreate(context.Background(), d, clientMock)
+	ast.Assert(t, !diags.HasError(), diags)
+	ast.Equal(t, d.Id(), "sports:dependency.sys.storage.api")
+
+	// the dependency is already registered
+	d = schema.TestResourceDataRaw(t, ResourceDomainDependency().Schema, map[string]interface{}{
+		"domain":  "sports",
+		"service": "sys.storage.api",
+	})
+	diags = resourceDomainDependencyCreate(context.Background(), d, clientMock)
+	ast.Assert(t, diags.HasError())
+	ast.Equal(t, diags[0].Summary, "the dependency of the domain sports on the service sys.storage.api already exists, use terraform import command")
+}
@@ -132,7 +132,7 @@ func resourceDomainMetaCreate(ctx context.Context, d *schema.ResourceData, meta
 	zmsClient := meta.(client.ZmsClient)
 
 	dn := d.Get("domain").(string)
-	resp := updateDomainMeta(zmsClient, dn, d)
+	resp := updateDomainMeta(ctx, zmsClient, dn, d)
 	if resp != nil {
 		return resp
 	}
@@ -140,9 +140,9 @@ func resourceDomainMetaCreate(ctx context.Context, d *schema.ResourceData, meta
 	return readAfterWrite(resourceDomainMetaRead, ctx, d, meta)
 }
 
-func resourceDomainMetaRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func resourceDomainMetaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	zmsClient := meta.(client.ZmsClient)
-	domain, err := zmsClient.GetDomain(d.Id())
+	domain, err := zmsClient.GetDomain(ctx, d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -213,14 +213,14 @@ func resourceDomainMetaRead(_ context.Context, d *schema.ResourceData, meta inte
 
 func resourceDomainMetaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	zmsClient := meta.(client.ZmsClient)
-	resp := updateDomainMeta(zmsClient, d.Id(), d)
+	resp := updateDomainMeta(ctx, zmsClient, d.Id(), d)
 	if resp != nil {
 		return resp
 	}
 	return readAfterWrite(resourceDomainMetaRead, ctx, d, meta)
 }
 
-func resourceDomainMetaDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func resourceDomainMetaDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	zmsClient := meta.(client.ZmsClient)
 	auditRef := d.Get("audit_ref").(string)
 	var zero int32
@@ -252,16 +252,16 @@ func resourceDomainMetaDelete(_ context.Context, d *schema.ResourceData, meta in
 			domainMeta.Contacts[zms.SimpleName(key)] = ""
 		}
 	}
-	err := zmsClient.PutDomainMeta(d.Id(), auditRef, &domainMeta)
+	err := zmsClient.PutDomainMeta(ctx, d.Id(), auditRef, &domainMeta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func updateDomainMeta(zmsClient client.ZmsClient, dn string, d *schema.ResourceData) diag.Diagnostics {
+func updateDomainMeta(ctx context.Context, zmsClient client.ZmsClient, dn string, d *schema.ResourceData) diag.Diagnostics {
 
-	domain, err := zmsClient.GetDomain(dn)
+	domain, err := zmsClient.GetDomain(ctx, dn)
 	if err != nil {
 		return diag.Errorf("domain %s does not exist", dn)
 	}
@@ -326,7 +326,7 @@ func updateDomainMeta(zmsClient client.ZmsClient, dn string, d *schema.ResourceD
 		domainMeta.Contacts = expandContactsMap(n.(map[string]interface{}))
 	}
 	auditRef := d.Get("audit_ref").(string)
-	err = zmsClient.PutDomainMeta(dn, auditRef, &domainMeta)
+	err = zmsClient.PutDomainMeta(ctx, dn, auditRef, &domainMeta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
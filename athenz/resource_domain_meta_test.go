@@ -1,6 +1,7 @@
 package athenz
 
 import (
+	"context"
 	"fmt"
 	"github.com/AthenZ/athenz/clients/go/zms"
 	"log"
@@ -57,7 +58,7 @@ func TestAccGroupDomainMetaBasic(t *testing.T) {
 
 func cleanAccTestDomainMeta(domainName string) {
 	zmsClient := testAccProvider.Meta().(client.ZmsClient)
-	_, err := zmsClient.GetDomain(domainName)
+	_, err := zmsClient.GetDomain(context.Background(), domainName)
 	if err == nil {
 		var zero int32
 		zero = 0
@@ -78,7 +79,7 @@ func cleanAccTestDomainMeta(domainName string) {
 			Tags:                  make(map[zms.TagKey]*zms.TagValueList),
 			Contacts:              make(map[zms.SimpleName]string),
 		}
-		if err = zmsClient.PutDomainMeta(domainName, AUDIT_REF, &domainMeta); err != nil {
+		if err = zmsClient.PutDomainMeta(context.Background(), domainName, AUDIT_REF, &domainMeta); err != nil {
 			log.Printf("unable to reset domain name for %s: %v\n", domainName, err)
 		}
 	}
@@ -96,7 +97,7 @@ func testAccCheckGroupDomainMetaExists(resource string) resource.TestCheckFunc {
 		}
 
 		zmsClient := testAccProvider.Meta().(client.ZmsClient)
-		domain, err := zmsClient.GetDomain(rs.Primary.ID)
+		domain, err := zmsClient.GetDomain(context.Background(), rs.Primary.ID)
 		if err != nil {
 			return err
 		}
@@ -115,7 +116,7 @@ func testAccCheckGroupDomainMetaDestroy(s *terraform.State) error {
 			continue
 		}
 
-		domain, _ := zmsClient.GetDomain(rs.Primary.ID)
+		domain, _ := zmsClient.GetDomain(context.Background(), rs.Primary.ID)
 
 		if domain != nil && domain.Description != "" {
 			return fmt.Errorf("athenz meta still exists")
@@ -0,0 +1,262 @@
+package athenz
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/AthenZ/athenz/clients/go/zms"
+	"github.com/AthenZ/terraform-provider-athenz/client"
+	"github.com/ardielle/ardielle-go/rdl"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// quotaFields maps the quota attributes to the matching ZMS quota field.
+var quotaFields = map[string]struct {
+	description string
+	field       func(quota *zms.Quota) *int32
+}{
+	"subdomain":    {"Maximum number of sub domains", func(q *zms.Quota) *int32 { return &q.Subdomain }},
+	"role":         {"Maximum number of roles", func(q *zms.Quota) *int32 { return &q.Role }},
+	"role_member":  {"Maximum number of members per role", func(q *zms.Quota) *int32 { return &q.RoleMember }},
+	"policy":       {"Maximum number of policies", func(q *zms.Quota) *int32 { return &q.Policy }},
+	"assertion":    {"Maximum number of assertions per policy", func(q *zms.Quota) *int32 { return &q.Assertion }},
+	"entity":       {"Maximum number of entities", func(q *zms.Quota) *int32 { return &q.Entity }},
+	"service":      {"Maximum number of services", func(q *zms.Quota) *int32 { return &q.Service }},
+	"service_host": {"Maximum number of hosts per service", func(q *zms.Quota) *int32 { return &q.ServiceHost }},
+	"public_key":   {"Maximum number of public keys per service", func(q *zms.Quota) *int32 { return &q.PublicKey }},
+	"group":        {"Maximum number of groups", func(q *zms.Quota) *int32 { return &q.Group }},
+	"group_member": {"Maximum number of members per group", func(q *zms.Quota) *int32 { return &q.GroupMember }},
+}
+
+func ResourceDomainQuota() *schema.Resource {
+	quotaSchema := map[string]*schema.Schema{
+		"domain": {
+			Type:             schema.TypeString,
+			Description:      "Name of the domain the quota applies to",
+			Required:         true,
+			ForceNew:         true,
+			ValidateDiagFunc: validatePatternFunc(DOMAIN_NAME),
+		},
+		"audit_ref": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  AUDIT_REF,
+		},
+	}
+	for name, quotaField := range quotaFields {
+		quotaSchema[name] = &schema.Schema{
+			Type:         schema.TypeInt,
+			Description:  quotaField.description + ", the server default is kept when not set",
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+		}
+	}
+	return &schema.Resource{
+		CreateContext: resourceDomainQuotaCreate,
+		ReadContext:   resourceDomainQuotaRead,
+		UpdateContext: resourceDomainQuotaUpdate,
+		DeleteContext: resourceDomainQuotaDelete,
+		CustomizeDiff: validateDomainQuota,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: quotaSchema,
+	}
+}
+
+func resourceDomainQuotaCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zmsClient := meta.(client.ZmsClient)
+	domainName := d.Get("domain").(string)
+	quota, diags := putDomainQuota(ctx, zmsClient, domainName, d)
+	if diags != nil {
+		return diags
+	}
+	// the quota is set from now on, a failure to check its usage must not
+	// leave it out of the state
+	d.SetId(domainName)
+	diags = quotaUsageDiags(ctx, zmsClient, domainName, quota)
+	if diags.HasError() {
+		return diags
+	}
+	return append(diags, readAfterWrite(resourceDomainQuotaRead, ctx, d, meta)...)
+}
+
+func resourceDomainQuotaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zmsClient := meta.(client.ZmsClient)
+	domainName := d.Id()
+	quota, err := zmsClient.GetQuota(ctx, domainName)
+	switch v := err.(type) {
+	case rdl.ResourceError:
+		if v.Code == 404 && !d.IsNewResource() {
+			log.Printf("[WARN] Athenz Domain %s not found, removing quota from state", domainName)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error retrieving Athenz Quota of domain %s: %s", domainName, v)
+	case rdl.Any:
+		return diag.FromErr(err)
+	}
+	if quota == nil {
+		return diag.Errorf("error retrieving Athenz Quota - Make sure your cert/key are valid")
+	}
+
+	if err = d.Set("domain", domainName); err != nil {
+		return diag.FromErr(err)
+	}
+	for name, value := range flattenQuota(quota) {
+		if err = d.Set(name, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+func resourceDomainQuotaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zmsClient := meta.(client.ZmsClient)
+	quota, diags := putDomainQuota(ctx, zmsClient, d.Id(), d)
+	if diags != nil {
+		return diags
+	}
+	diags = quotaUsageDiags(ctx, zmsClient, d.Id(), quota)
+	if diags.HasError() {
+		return diags
+	}
+	return append(diags, readAfterWrite(resourceDomainQuotaRead, ctx, d, meta)...)
+}
+
+func resourceDomainQuotaDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zmsClient := meta.(client.ZmsClient)
+	auditRef := d.Get("audit_ref").(string)
+	err := zmsClient.DeleteQuota(ctx, d.Id(), auditRef)
+
+	switch v := err.(type) {
+	case rdl.ResourceError:
+		if v.Code == 404 {
+			return nil
+		}
+		return diag.FromErr(err)
+	case rdl.Any:
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// validateDomainQuota fails the plan when the domain already holds more roles
+// or services than the planned quota allows. A domain created in the same
+// plan is not checked.
+func validateDomainQuota(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("role") && !d.HasChange("service") {
+		return nil
+	}
+	if !d.NewValueKnown("domain") {
+		return nil
+	}
+	roleQuota, serviceQuota := 0, 0
+	if d.NewValueKnown("role") {
+		roleQuota = d.Get("role").(int)
+	}
+	if d.NewValueKnown("service") {
+		serviceQuota = d.Get("service").(int)
+	}
+	warnings, err := quotaUsageWarnings(ctx, meta.(client.ZmsClient), d.Get("domain").(string), roleQuota, serviceQuota)
+	if v, ok := err.(rdl.ResourceError); ok && v.Code == 404 {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error checking the usage of the Athenz Quota: %s", err)
+	}
+	if len(warnings) > 0 {
+		return fmt.Errorf("%s", strings.Join(warnings, ", "))
+	}
+	return nil
+}
+
+// putDomainQuota sets the quota of the domain, the attributes which are not
+// configured keep their current value.
+func putDomainQuota(ctx context.Context, zmsClient client.ZmsClient, domainName string, d *schema.ResourceData) (*zms.Quota, diag.Diagnostics) {
+	quota, err := zmsClient.GetQuota(ctx, domainName)
+	if err != nil {
+		return nil, diag.Errorf("error retrieving Athenz Quota of domain %s: %s", domainName, err)
+	}
+	quota.Name = zms.DomainName(domainName)
+	quota.Modified = nil
+	expandQuota(quota, d)
+	if err = zmsClient.PutQuota(ctx, domainName, d.Get("audit_ref").(string), quota); err != nil {
+		return nil, diag.Errorf("error updating quota of domain %s: %s", domainName, err)
+	}
+	return quota, nil
+}
+
+// quotaUsageDiags returns a warning when the domain exceeds the quota, e.g.
+// when roles were added between the plan and the apply.
+func quotaUsageDiags(ctx context.Context, zmsClient client.ZmsClient, domainName string, quota *zms.Quota) diag.Diagnostics {
+	warnings, err := quotaUsageWarnings(ctx, zmsClient, domainName, int(quota.Role), int(quota.Service))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var diags diag.Diagnostics
+	for _, warning := range warnings {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  warning,
+		})
+	}
+	return diags
+}
+
+// expandQuota sets the configured attributes on the quota. The raw config is
+// checked since d.GetOk cannot tell a configured 0 from an unset attribute.
+func expandQuota(quota *zms.Quota, d *schema.ResourceData) {
+	rawConfig := d.GetRawConfig()
+	for name, quotaField := range quotaFields {
+		if isQuotaConfigured(rawConfig, name) {
+			*quotaField.field(quota) = int32(d.Get(name).(int))
+		}
+	}
+}
+
+func isQuotaConfigured(rawConfig cty.Value, name string) bool {
+	if rawConfig.IsNull() || !rawConfig.IsKnown() || !rawConfig.Type().IsObjectType() || !rawConfig.Type().HasAttribute(name) {
+		return false
+	}
+	return !rawConfig.GetAttr(name).IsNull()
+}
+
+func flattenQuota(quota *zms.Quota) map[string]interface{} {
+	values := make(map[string]interface{}, len(quotaFields))
+	for name, quotaField := range quotaFields {
+		values[name] = int(*quotaField.field(quota))
+	}
+	return values
+}
+
+// quotaUsageWarnings compares the roles and services of the domain with the
+// given quota, a quota of 0 is not checked.
+func quotaUsageWarnings(ctx context.Context, zmsClient client.ZmsClient, domainName string, roleQuota, serviceQuota int) ([]string, error) {
+	var warnings []string
+	if roleQuota > 0 {
+		roles, err := zmsClient.GetRoleList(ctx, domainName, nil, "")
+		if err != nil {
+			return nil, err
+		}
+		if len(roles.Names) > roleQuota {
+			warnings = append(warnings, fmt.Sprintf("the domain %s has %d roles, more than the role quota of %d", domainName, len(roles.Names), roleQuota))
+		}
+	}
+	if serviceQuota > 0 {
+		services, err := zmsClient.GetServiceIdentityList(ctx, domainName, nil, "")
+		if err != nil {
+			return nil, err
+		}
+		if len(services.Names) > serviceQuota {
+			warnings = append(warnings, fmt.Sprintf("the domain %s has %d services, more than the service quota of %d", domainName, len(services.Names), serviceQuota))
+		}
+	}
+	return warnings, nil
+}
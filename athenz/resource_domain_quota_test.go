@@ -0,0 +1,193 @@
+package athenz
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"testing"
+
+	"github.com/AthenZ/athenz/clients/go/zms"
+	"github.com/AthenZ/terraform-provider-athenz/client"
+	"github.com/ardielle/ardielle-go/rdl"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ast "gotest.tools/assert"
+)
+
+func TestAccDomainQuotaBasic(t *testing.T) {
+	if v := os.Getenv("TF_ACC"); v != "1" && v != "true" {
+		log.Print("TF_ACC must be set for acceptance tests")
+		return
+	}
+	if v := os.Getenv("DOMAIN"); v == "" {
+		t.Fatal("DOMAIN must be set for acceptance tests")
+	}
+	resourceName := "athenz_domain_quota.quotaTest"
+	dataSourceName := "data.athenz_domain_quota.quotaTest"
+	domain := os.Getenv("DOMAIN")
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainQuotaConfig(domain, 500, 100),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainQuota(resourceName, 500, 100),
+					resource.TestCheckResourceAttrSet(resourceName, "policy"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "role", resourceName, "role"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "policy", resourceName, "policy"),
+				),
+			},
+			{
+				Config: testAccDomainQuotaConfig(domain, 600, 200),
+				Check:  testAccCheckDomainQuota(resourceName, 600, 200),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"audit_ref"},
+			},
+		},
+	})
+}
+
+func testAccCheckDomainQuota(n string, role, service int32) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no Athenz Quota ID is set")
+		}
+		zmsClient := testAccProvider.Meta().(client.ZmsClient)
+		quota, err := zmsClient.GetQuota(context.Background(), rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if quota.Role != role || quota.Service != service {
+			return fmt.Errorf("unexpected quota of domain %s: role %d, service %d", rs.Primary.ID, quota.Role, quota.Service)
+		}
+		return nil
+	}
+}
+
+func testAccDomainQuotaConfig(domain string, role, service int) string {
+	return fmt.Sprintf(`
+resource "athenz_domain_quota" "quotaTest" {
+  domain = "%s"
+  role = %d
+  service = %d
+}
+
+data "athenz_domain_quota" "quotaTest" {
+  domain = athenz_domain_quota.quotaTest.domain
+}
+`, domain, role, service)
+}
+
+func TestExpandQuota(t *testing.T) {
+	d := testQuotaResourceData(t, map[string]int{"role": 500, "role_member": 200})
+	quota := &zms.Quota{Name: "some_domain", Role: 1000, RoleMember: 100, Policy: 1000}
+	expandQuota(quota, d)
+	ast.DeepEqual(t, quota, &zms.Quota{Name: "some_domain", Role: 500, RoleMember: 200, Policy: 1000})
+
+	// a configured 0 is sent, unlike an attribute which is not configured
+	d = testQuotaResourceData(t, map[string]int{"role": 0, "service": 0})
+	quota = &zms.Quota{Name: "some_domain", Role: 1000, Service: 250, Policy: 1000}
+	expandQuota(quota, d)
+	ast.DeepEqual(t, quota, &zms.Quota{Name: "some_domain", Role: 0, Service: 0, Policy: 1000})
+}
+
+// testQuotaResourceData returns the resource data of a quota with the given
+// attributes configured, including the raw config expandQuota relies on.
+func testQuotaResourceData(t *testing.T, values map[string]int) *schema.ResourceData {
+	attributes := map[string]string{"domain": "some_domain"}
+	config := map[string]cty.Value{"domain": cty.StringVal("some_domain")}
+	for name, value := range values {
+		attributes[name] = fmt.Sprint(value)
+		config[name] = cty.NumberIntVal(int64(value))
+	}
+	d := ResourceDomainQuota().Data(&terraform.InstanceState{
+		ID:         "some_domain",
+		Attributes: attributes,
+		RawConfig:  cty.ObjectVal(config),
+	})
+	ast.Equal(t, d.Get("domain"), "some_domain")
+	return d
+}
+
+func TestFlattenQuota(t *testing.T) {
+	quota := &zms.Quota{Subdomain: 1, Role: 2, RoleMember: 3, Policy: 4, Assertion: 5, Entity: 6, Service: 7, ServiceHost: 8, PublicKey: 9, Group: 10, GroupMember: 11}
+	expected := map[string]interface{}{
+		"subdomain":    1,
+		"role":         2,
+		"role_member":  3,
+		"policy":       4,
+		"assertion":    5,
+		"entity":       6,
+		"service":      7,
+		"service_host": 8,
+		"public_key":   9,
+		"group":        10,
+		"group_member": 11,
+	}
+	ast.DeepEqual(t, flattenQuota(quota), expected)
+}
+
+func TestQuotaUsageWarnings(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	clientMock := client.NewMockZmsClient(mockCtrl)
+	clientMock.EXPECT().GetRoleList(gomock.Any(), "some_domain", nil, "").Return(&zms.RoleList{Names: []zms.EntityName{"admin", "readers", "writers"}}, nil).AnyTimes()
+	clientMock.EXPECT().GetServiceIdentityList(gomock.Any(), "some_domain", nil, "").Return(&zms.ServiceIdentityList{Names: []zms.EntityName{"api"}}, nil).AnyTimes()
+
+	warnings, err := quotaUsageWarnings(context.Background(), clientMock, "some_domain", 2, 1)
+	ast.NilError(t, err)
+	ast.DeepEqual(t, warnings, []string{"the domain some_domain has 3 roles, more than the role quota of 2"})
+
+	warnings, err = quotaUsageWarnings(context.Background(), clientMock, "some_domain", 3, 0)
+	ast.NilError(t, err)
+	ast.Equal(t, len(warnings), 0)
+}
+
+func TestValidateDomainQuota(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	clientMock := client.NewMockZmsClient(mockCtrl)
+	clientMock.EXPECT().GetRoleList(gomock.Any(), "some_domain", nil, "").Return(&zms.RoleList{Names: []zms.EntityName{"admin", "readers", "writers"}}, nil).AnyTimes()
+	clientMock.EXPECT().GetRoleList(gomock.Any(), "new_domain", nil, "").Return(nil, rdl.ResourceError{Code: 404}).AnyTimes()
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{"domain": "some_domain", "role": 2})
+	_, err := ResourceDomainQuota().Diff(context.Background(), nil, config, clientMock)
+	ast.Error(t, err, "the domain some_domain has 3 roles, more than the role quota of 2")
+
+	config = terraform.NewResourceConfigRaw(map[string]interface{}{"domain": "some_domain", "role": 3})
+	_, err = ResourceDomainQuota().Diff(context.Background(), nil, config, clientMock)
+	ast.NilError(t, err)
+
+	// the domain is created in the same plan
+	config = terraform.NewResourceConfigRaw(map[string]interface{}{"domain": "new_domain", "role": 2})
+	_, err = ResourceDomainQuota().Diff(context.Background(), nil, config, clientMock)
+	ast.NilError(t, err)
+}
+
+func TestDomainQuotaCreateUsageFailure(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	clientMock := client.NewMockZmsClient(mockCtrl)
+	gomock.InOrder(
+		clientMock.EXPECT().GetQuota(gomock.Any(), "some_domain").Return(&zms.Quota{Name: "some_domain", Role: 1000}, nil),
+		clientMock.EXPECT().PutQuota(gomock.Any(), "some_domain", gomock.Any(), gomock.Any()).Return(nil),
+		clientMock.EXPECT().GetRoleList(gomock.Any(), "some_domain", nil, "").Return(nil, rdl.ResourceError{Code: 500, Message: "Internal Server Error"}),
+	)
+
+	d := testQuotaResourceData(t, map[string]int{"role": 500})
+	d.SetId("")
+	diags := resourceDomainQuotaCreate(context.Background(), d, clientMock)
+	ast.Assert(t, diags.HasError())
+	// the quota was set, it must be kept in the state
+	ast.Equal(t, d.Id(), "some_domain")
+}
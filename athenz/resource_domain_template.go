@@ -0,0 +1,196 @@
+package athenz
+
+import (
+	"context"
+	"log"
+
+	"github.com/AthenZ/athenz/clients/go/zms"
+	"github.com/AthenZ/terraform-provider-athenz/client"
+	"github.com/ardielle/ardielle-go/rdl"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceDomainTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDomainTemplateCreate,
+		ReadContext:   resourceDomainTemplateRead,
+		UpdateContext: resourceDomainTemplateUpdate,
+		DeleteContext: resourceDomainTemplateDelete,
+		CustomizeDiff: resourceDomainTemplateCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:             schema.TypeString,
+				Description:      "Name of the domain the template is applied to",
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validatePatternFunc(DOMAIN_NAME),
+			},
+			"name": {
+				Type:             schema.TypeString,
+				Description:      "Name of the server solution template, e.g. aws",
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validatePatternFunc(SIMPLE_NAME),
+			},
+			"params": {
+				Type:        schema.TypeMap,
+				Description: "Values for the keywords of the template, e.g. service. Apply-only: ZMS does not return them, so they are neither imported nor checked for drift",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"audit_ref": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  AUDIT_REF,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "Description of the template",
+				Computed:    true,
+			},
+			"current_version": {
+				Type:        schema.TypeInt,
+				Description: "Version of the template applied to the domain",
+				Computed:    true,
+			},
+			"latest_version": {
+				Type:        schema.TypeInt,
+				Description: "Latest version of the template available on the server",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceDomainTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zmsClient := meta.(client.ZmsClient)
+	domainName := d.Get("domain").(string)
+	templateName := d.Get("name").(string)
+
+	templates, err := zmsClient.GetDomainTemplateDetailsList(ctx, domainName)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if findTemplateMetaData(templates, templateName) != nil {
+		return diag.Errorf("the template %s is already applied to the domain %s, use terraform import command", templateName, domainName)
+	}
+	if diags := putDomainTemplate(ctx, zmsClient, domainName, templateName, d); diags != nil {
+		return diags
+	}
+	d.SetId(domainName + TEMPLATE_SEPARATOR + templateName)
+	return readAfterWrite(resourceDomainTemplateRead, ctx, d, meta)
+}
+
+func resourceDomainTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zmsClient := meta.(client.ZmsClient)
+	domainName, templateName, err := splitTemplateId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	templates, err := zmsClient.GetDomainTemplateDetailsList(ctx, domainName)
+	switch v := err.(type) {
+	case rdl.ResourceError:
+		if v.Code == 404 && !d.IsNewResource() {
+			log.Printf("[WARN] Athenz Domain %s not found, removing template %s from state", domainName, templateName)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	case rdl.Any:
+		return diag.FromErr(err)
+	}
+	metaData := findTemplateMetaData(templates, templateName)
+	if metaData == nil {
+		if !d.IsNewResource() {
+			log.Printf("[WARN] Athenz Template %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("the template %s is not applied to the domain %s", templateName, domainName)
+	}
+
+	if err = d.Set("domain", domainName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("name", templateName); err != nil {
+		return diag.FromErr(err)
+	}
+	// params are apply-only, ZMS does not return the keyword values the
+	// template was applied with
+	if err = d.Set("description", metaData.Description); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("current_version", int32Value(metaData.CurrentVersion)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("latest_version", int32Value(metaData.LatestVersion)); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceDomainTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zmsClient := meta.(client.ZmsClient)
+	domainName, templateName, err := splitTemplateId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// applying the template again updates it to the latest server version
+	if diags := putDomainTemplate(ctx, zmsClient, domainName, templateName, d); diags != nil {
+		return diags
+	}
+	return readAfterWrite(resourceDomainTemplateRead, ctx, d, meta)
+}
+
+func resourceDomainTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zmsClient := meta.(client.ZmsClient)
+	domainName, templateName, err := splitTemplateId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	auditRef := d.Get("audit_ref").(string)
+	err = zmsClient.DeleteDomainTemplate(ctx, domainName, templateName, auditRef)
+
+	switch v := err.(type) {
+	case rdl.ResourceError:
+		if v.Code == 404 {
+			return nil
+		}
+		return diag.FromErr(err)
+	case rdl.Any:
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// resourceDomainTemplateCustomizeDiff plans an update when the server has a
+// newer version of the template than the one applied to the domain.
+func resourceDomainTemplateCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	currentVersion := d.Get("current_version").(int)
+	latestVersion := d.Get("latest_version").(int)
+	if latestVersion > currentVersion {
+		return d.SetNew("current_version", latestVersion)
+	}
+	return nil
+}
+
+func putDomainTemplate(ctx context.Context, zmsClient client.ZmsClient, domainName, templateName string, d *schema.ResourceData) diag.Diagnostics {
+	template := zms.DomainTemplate{
+		TemplateNames: []zms.SimpleName{zms.SimpleName(templateName)},
+		Params:        expandTemplateParams(d.Get("params").(map[string]interface{})),
+	}
+	if err := zmsClient.PutDomainTemplate(ctx, domainName, templateName, d.Get("audit_ref").(string), &template); err != nil {
+		return diag.Errorf("error applying template %s to domain %s: %s", templateName, domainName, err)
+	}
+	return nil
+}
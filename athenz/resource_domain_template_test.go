@@ -0,0 +1,108 @@
+package athenz
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"testing"
+
+	"github.com/AthenZ/terraform-provider-athenz/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDomainTemplateBasic(t *testing.T) {
+	if v := os.Getenv("TF_ACC"); v != "1" && v != "true" {
+		log.Print("TF_ACC must be set for acceptance tests")
+		return
+	}
+	if v := os.Getenv("DOMAIN"); v == "" {
+		t.Fatal("DOMAIN must be set for acceptance tests")
+	}
+	resourceName := "athenz_domain_template.templateTest"
+	domain := os.Getenv("DOMAIN")
+	serviceName := fmt.Sprintf("test%d", acctest.RandInt())
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDomainTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainTemplateConfig(domain, serviceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainTemplateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "zts_instance_provider"),
+					resource.TestCheckResourceAttr(resourceName, "params.service", serviceName),
+					resource.TestCheckResourceAttrSet(resourceName, "current_version"),
+					resource.TestCheckResourceAttrPair(resourceName, "current_version", resourceName, "latest_version"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"params", "audit_ref"},
+			},
+		},
+	})
+}
+
+func testAccCheckDomainTemplateExists(n string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no Athenz Template ID is set")
+		}
+		dn, tn, err := splitTemplateId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		zmsClient := testAccProvider.Meta().(client.ZmsClient)
+		templates, err := zmsClient.GetDomainTemplateDetailsList(context.Background(), dn)
+		if err != nil {
+			return err
+		}
+		if findTemplateMetaData(templates, tn) == nil {
+			return fmt.Errorf("template %s is not applied to domain %s", tn, dn)
+		}
+		return nil
+	}
+}
+
+func testAccCheckDomainTemplateDestroy(s *terraform.State) error {
+	zmsClient := testAccProvider.Meta().(client.ZmsClient)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "athenz_domain_template" {
+			continue
+		}
+		dn, tn, err := splitTemplateId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		templates, err := zmsClient.GetDomainTemplateDetailsList(context.Background(), dn)
+		if err != nil {
+			return err
+		}
+		if findTemplateMetaData(templates, tn) != nil {
+			return fmt.Errorf("athenz Template %s still applied", tn)
+		}
+	}
+	return nil
+}
+
+func testAccDomainTemplateConfig(domain, service string) string {
+	return fmt.Sprintf(`
+resource "athenz_domain_template" "templateTest" {
+  domain = "%s"
+  name = "zts_instance_provider"
+  params = {
+    service = "%s"
+  }
+}
+`, domain, service)
+}
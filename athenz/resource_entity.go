@@ -0,0 +1,162 @@
+package athenz
+
+import (
+	"context"
+	"log"
+
+	"github.com/AthenZ/athenz/clients/go/zms"
+	"github.com/AthenZ/terraform-provider-athenz/client"
+	"github.com/ardielle/ardielle-go/rdl"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceEntity() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceEntityCreate,
+		ReadContext:   resourceEntityRead,
+		UpdateContext: resourceEntityUpdate,
+		DeleteContext: resourceEntityDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:             schema.TypeString,
+				Description:      "Name of the domain the entity belongs to",
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validatePatternFunc(DOMAIN_NAME),
+			},
+			"name": {
+				Type:             schema.TypeString,
+				Description:      "Name of the entity",
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validatePatternFunc(ENTITY_NAME),
+			},
+			"value": {
+				Type:             schema.TypeString,
+				Description:      "Value of the entity, a JSON object",
+				Required:         true,
+				StateFunc:        normalizeEntityValue,
+				ValidateDiagFunc: validateEntityValue,
+			},
+			"audit_ref": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  AUDIT_REF,
+			},
+		},
+	}
+}
+
+func resourceEntityCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zmsClient := meta.(client.ZmsClient)
+	domainName := d.Get("domain").(string)
+	entityName := d.Get("name").(string)
+
+	_, err := zmsClient.GetEntity(ctx, domainName, entityName)
+	switch v := err.(type) {
+	case rdl.ResourceError:
+		if v.Code != 404 {
+			return diag.FromErr(err)
+		}
+	case rdl.Any:
+		return diag.FromErr(err)
+	case nil:
+		return diag.Errorf("the entity %s already exists in the domain %s, use terraform import command", entityName, domainName)
+	}
+	if diags := putEntity(ctx, zmsClient, domainName, entityName, d); diags != nil {
+		return diags
+	}
+	d.SetId(domainName + ENTITY_SEPARATOR + entityName)
+	return readAfterWrite(resourceEntityRead, ctx, d, meta)
+}
+
+func resourceEntityRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zmsClient := meta.(client.ZmsClient)
+	domainName, entityName, err := splitEntityId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	entity, err := zmsClient.GetEntity(ctx, domainName, entityName)
+	switch v := err.(type) {
+	case rdl.ResourceError:
+		if v.Code == 404 && !d.IsNewResource() {
+			log.Printf("[WARN] Athenz Entity %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error retrieving Athenz Entity %s: %s", d.Id(), v)
+	case rdl.Any:
+		return diag.FromErr(err)
+	}
+	if entity == nil {
+		return diag.Errorf("error retrieving Athenz Entity - Make sure your cert/key are valid")
+	}
+
+	value, err := flattenEntityValue(entity.Value)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("domain", domainName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("name", entityName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("value", value); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceEntityUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zmsClient := meta.(client.ZmsClient)
+	domainName, entityName, err := splitEntityId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if diags := putEntity(ctx, zmsClient, domainName, entityName, d); diags != nil {
+		return diags
+	}
+	return readAfterWrite(resourceEntityRead, ctx, d, meta)
+}
+
+func resourceEntityDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zmsClient := meta.(client.ZmsClient)
+	domainName, entityName, err := splitEntityId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	auditRef := d.Get("audit_ref").(string)
+	err = zmsClient.DeleteEntity(ctx, domainName, entityName, auditRef)
+
+	switch v := err.(type) {
+	case rdl.ResourceError:
+		if v.Code == 404 {
+			return nil
+		}
+		return diag.FromErr(err)
+	case rdl.Any:
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func putEntity(ctx context.Context, zmsClient client.ZmsClient, domainName, entityName string, d *schema.ResourceData) diag.Diagnostics {
+	value, err := expandEntityValue(d.Get("value").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	entity := zms.Entity{
+		Name:  zms.ResourceName(entityName),
+		Value: value,
+	}
+	if err = zmsClient.PutEntity(ctx, domainName, entityName, d.Get("audit_ref").(string), &entity); err != nil {
+		return diag.Errorf("error updating entity %s in domain %s: %s", entityName, domainName, err)
+	}
+	return nil
+}
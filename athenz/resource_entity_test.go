@@ -0,0 +1,126 @@
+package athenz
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"testing"
+
+	"github.com/AthenZ/terraform-provider-athenz/client"
+	"github.com/ardielle/ardielle-go/rdl"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccEntityBasic(t *testing.T) {
+	if v := os.Getenv("TF_ACC"); v != "1" && v != "true" {
+		log.Print("TF_ACC must be set for acceptance tests")
+		return
+	}
+	if v := os.Getenv("DOMAIN"); v == "" {
+		t.Fatal("DOMAIN must be set for acceptance tests")
+	}
+	resourceName := "athenz_entity.entityTest"
+	dataSourceName := "data.athenz_entity.entityTest"
+	listDataSourceName := "data.athenz_entities.entitiesTest"
+	domain := os.Getenv("DOMAIN")
+	entityName := fmt.Sprintf("test%d", acctest.RandInt())
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckEntityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEntityConfig(domain, entityName, `{"owner": "team", "limits": {"max": 10}}`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEntityExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", entityName),
+					resource.TestCheckResourceAttr(resourceName, "value", `{"limits":{"max":10},"owner":"team"}`),
+					resource.TestCheckResourceAttrPair(dataSourceName, "value", resourceName, "value"),
+					resource.TestCheckTypeSetElemAttr(listDataSourceName, "names.*", entityName),
+				),
+			},
+			{
+				// reordering the keys does not produce a diff
+				Config:   testAccEntityConfig(domain, entityName, `{"limits": {"max": 10}, "owner": "team"}`),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccEntityConfig(domain, entityName, `{"owner": "other"}`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEntityExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "value", `{"owner":"other"}`),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"audit_ref"},
+			},
+		},
+	})
+}
+
+func testAccCheckEntityExists(n string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no Athenz Entity ID is set")
+		}
+		dn, en, err := splitEntityId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		zmsClient := testAccProvider.Meta().(client.ZmsClient)
+		if _, err = zmsClient.GetEntity(context.Background(), dn, en); err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccCheckEntityDestroy(s *terraform.State) error {
+	zmsClient := testAccProvider.Meta().(client.ZmsClient)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "athenz_entity" {
+			continue
+		}
+		dn, en, err := splitEntityId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		_, err = zmsClient.GetEntity(context.Background(), dn, en)
+		if err == nil {
+			return fmt.Errorf("athenz Entity still exists")
+		}
+		if v, ok := err.(rdl.ResourceError); !ok || v.Code != 404 {
+			return err
+		}
+	}
+	return nil
+}
+
+func testAccEntityConfig(domain, name, value string) string {
+	return fmt.Sprintf(`
+resource "athenz_entity" "entityTest" {
+  domain = "%s"
+  name = "%s"
+  value = %q
+}
+
+data "athenz_entity" "entityTest" {
+  domain = athenz_entity.entityTest.domain
+  name = athenz_entity.entityTest.name
+}
+
+data "athenz_entities" "entitiesTest" {
+  domain = athenz_entity.entityTest.domain
+}
+`, domain, name, value)
+}
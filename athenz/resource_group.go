@@ -69,6 +69,7 @@ func ResourceGroup() *schema.Resource {
 					},
 				},
 			},
+			"pending_member": pendingGroupMemberSchema(),
 			"settings": {
 				Type:        schema.TypeSet,
 				Description: "Advanced settings",
@@ -173,7 +174,7 @@ func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, meta inter
 	dn := d.Get("domain").(string)
 	gn := d.Get("name").(string)
 	fullResourceName := dn + GROUP_SEPARATOR + gn
-	groupCheck, err := zmsClient.GetGroup(dn, gn)
+	groupCheck, err := zmsClient.GetGroup(ctx, dn, gn)
 	switch v := err.(type) {
 	case rdl.ResourceError:
 		if v.Code == 404 {
@@ -222,7 +223,7 @@ func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, meta inter
 			group.SelfRenewMins = &selfRenewMins
 			auditEnabled := d.Get("audit_enabled").(bool)
 			group.AuditEnabled = &auditEnabled
-			if err = zmsClient.PutGroup(dn, gn, auditRef, &group); err != nil {
+			if err = zmsClient.PutGroup(ctx, dn, gn, auditRef, &group); err != nil {
 				return diag.FromErr(err)
 			}
 		} else {
@@ -241,7 +242,7 @@ func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, meta inter
 	return readAfterWrite(resourceGroupRead, ctx, d, meta)
 }
 
-func resourceGroupRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func resourceGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	zmsClient := meta.(client.ZmsClient)
 
 	dn, gn, err := splitGroupId(d.Id())
@@ -255,7 +256,7 @@ func resourceGroupRead(_ context.Context, d *schema.ResourceData, meta interface
 		return diag.FromErr(err)
 	}
 
-	group, err := zmsClient.GetGroup(dn, gn)
+	group, err := zmsClient.GetGroupWithPendingMembers(ctx, dn, gn)
 	switch v := err.(type) {
 	case rdl.ResourceError:
 		if v.Code == 404 {
@@ -275,13 +276,17 @@ func resourceGroupRead(_ context.Context, d *schema.ResourceData, meta interface
 		return diag.Errorf("error retrieving Athenz Group - Make sure your cert/key are valid")
 	}
 
-	if len(group.GroupMembers) > 0 {
+	groupMembers, pendingMembers := splitPendingGroupMembers(group.GroupMembers, knownMemberNames(d))
+	if err = d.Set("pending_member", flattenPendingGroupMembers(pendingMembers)); err != nil {
+		return diag.FromErr(err)
+	}
+	if len(groupMembers) > 0 {
 		if _, ok := d.GetOk("members"); ok {
-			if err = d.Set("members", flattenDeprecatedGroupMembers(group.GroupMembers)); err != nil {
+			if err = d.Set("members", flattenDeprecatedGroupMembers(groupMembers)); err != nil {
 				return diag.FromErr(err)
 			}
 		} else {
-			if err = d.Set("member", flattenGroupMembers(group.GroupMembers)); err != nil {
+			if err = d.Set("member", flattenGroupMembers(groupMembers)); err != nil {
 				return diag.FromErr(err)
 			}
 		}
@@ -377,7 +382,7 @@ func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		return diag.FromErr(err)
 	}
 	auditRef := d.Get("audit_ref").(string)
-	group, err := zmsClient.GetGroup(dn, gn)
+	group, err := zmsClient.GetGroup(ctx, dn, gn)
 
 	if err != nil {
 		return diag.FromErr(err)
@@ -456,7 +461,7 @@ func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		group.AuditEnabled = &auditEnabled
 	}
 
-	err = zmsClient.PutGroup(dn, gn, auditRef, group)
+	err = zmsClient.PutGroup(ctx, dn, gn, auditRef, group)
 	if err != nil {
 		return diag.Errorf("error updating group: %s", err)
 	}
@@ -464,14 +469,14 @@ func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 	return readAfterWrite(resourceGroupRead, ctx, d, meta)
 }
 
-func resourceGroupDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func resourceGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	zmsClient := meta.(client.ZmsClient)
 	dn, gn, err := splitGroupId(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	auditRef := d.Get("audit_ref").(string)
-	err = zmsClient.DeleteGroup(dn, gn, auditRef)
+	err = zmsClient.DeleteGroup(ctx, dn, gn, auditRef)
 
 	switch v := err.(type) {
 	case rdl.ResourceError:
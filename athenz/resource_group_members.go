@@ -74,7 +74,7 @@ func resourceGroupMembersCreate(ctx context.Context, d *schema.ResourceData, met
 	gn := d.Get("name").(string)
 	fullResourceName := dn + GROUP_SEPARATOR + gn
 
-	_, err := zmsClient.GetGroup(dn, gn)
+	_, err := zmsClient.GetGroup(ctx, dn, gn)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -87,7 +87,7 @@ func resourceGroupMembersCreate(ctx context.Context, d *schema.ResourceData, met
 				MemberName: member.MemberName,
 				Expiration: member.Expiration,
 			}
-			err = zmsClient.PutGroupMembership(dn, gn, member.MemberName, auditRef, &membership)
+			err = zmsClient.PutGroupMembership(ctx, dn, gn, member.MemberName, auditRef, &membership)
 			if err != nil {
 				return diag.Errorf("error adding group member: %v", err)
 			}
@@ -98,7 +98,7 @@ func resourceGroupMembersCreate(ctx context.Context, d *schema.ResourceData, met
 	return readAfterWrite(resourceGroupMembersRead, ctx, d, meta)
 }
 
-func resourceGroupMembersRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func resourceGroupMembersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	zmsClient := meta.(client.ZmsClient)
 
 	dn, gn, err := splitGroupId(d.Id())
@@ -112,7 +112,7 @@ func resourceGroupMembersRead(_ context.Context, d *schema.ResourceData, meta in
 		return diag.FromErr(err)
 	}
 
-	group, err := zmsClient.GetGroup(dn, gn)
+	group, err := zmsClient.GetGroup(ctx, dn, gn)
 	switch v := err.(type) {
 	case rdl.ResourceError:
 		if v.Code == 404 {
@@ -151,7 +151,7 @@ func resourceGroupMembersUpdate(ctx context.Context, d *schema.ResourceData, met
 	membersToDelete := make([]*zms.GroupMember, 0)
 	membersToAdd := make([]*zms.GroupMember, 0)
 
-	_, err = zmsClient.GetGroup(dn, gn)
+	_, err = zmsClient.GetGroup(ctx, dn, gn)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -162,7 +162,7 @@ func resourceGroupMembersUpdate(ctx context.Context, d *schema.ResourceData, met
 		membersToAdd = append(membersToAdd, expandGroupMembers(newVal.Difference(oldVal).List())...)
 	}
 
-	err = updateGroupMembers(dn, gn, membersToDelete, membersToAdd, zmsClient, auditRef)
+	err = updateGroupMembers(ctx, dn, gn, membersToDelete, membersToAdd, zmsClient, auditRef)
 	if err != nil {
 		return diag.Errorf("error updating group membership: %s", err)
 	}
@@ -170,14 +170,14 @@ func resourceGroupMembersUpdate(ctx context.Context, d *schema.ResourceData, met
 	return readAfterWrite(resourceGroupMembersRead, ctx, d, meta)
 }
 
-func resourceGroupMembersDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func resourceGroupMembersDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	zmsClient := meta.(client.ZmsClient)
 	dn, gn, err := splitGroupId(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	auditRef := d.Get("audit_ref").(string)
-	group, err := zmsClient.GetGroup(dn, gn)
+	group, err := zmsClient.GetGroup(ctx, dn, gn)
 	if err != nil {
 		switch v := err.(type) {
 		case rdl.ResourceError:
@@ -190,7 +190,7 @@ func resourceGroupMembersDelete(_ context.Context, d *schema.ResourceData, meta
 		}
 	}
 	for _, member := range group.GroupMembers {
-		err = zmsClient.DeleteGroupMembership(dn, gn, member.MemberName, auditRef)
+		err = zmsClient.DeleteGroupMembership(ctx, dn, gn, member.MemberName, auditRef)
 		if err != nil {
 			return diag.FromErr(err)
 		}
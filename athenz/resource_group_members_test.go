@@ -1,6 +1,7 @@
 package athenz
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
@@ -95,15 +96,15 @@ func createTestGroupForMembers(dn, gn string) error {
 	group := zms.Group{
 		Name: zms.ResourceName(gn),
 	}
-	return zmsClient.PutGroup(dn, gn, AUDIT_REF, &group)
+	return zmsClient.PutGroup(context.Background(), dn, gn, AUDIT_REF, &group)
 }
 
 func cleanAllAccTestGroupMembers(domain string, groups []string) {
 	zmsClient := testAccProvider.Meta().(client.ZmsClient)
 	for _, groupName := range groups {
-		_, err := zmsClient.GetGroup(domain, groupName)
+		_, err := zmsClient.GetGroup(context.Background(), domain, groupName)
 		if err == nil {
-			if err = zmsClient.DeleteGroup(domain, groupName, AUDIT_REF); err != nil {
+			if err = zmsClient.DeleteGroup(context.Background(), domain, groupName, AUDIT_REF); err != nil {
 				log.Printf("error deleting Group %s: %s", groupName, err)
 			}
 		}
@@ -125,7 +126,7 @@ func testAccCheckGroupMembersExists(resourceName string) resource.TestCheckFunc
 		dn, gn := fullResourceName[0], fullResourceName[1]
 
 		zmsClient := testAccProvider.Meta().(client.ZmsClient)
-		_, err := zmsClient.GetGroup(dn, gn)
+		_, err := zmsClient.GetGroup(context.Background(), dn, gn)
 		if err != nil {
 			return err
 		}
@@ -144,12 +145,12 @@ func testAccCheckGroupMembersDestroy(s *terraform.State) error {
 		fullResourceName := strings.Split(rs.Primary.ID, GROUP_SEPARATOR)
 		dn, gn := fullResourceName[0], fullResourceName[1]
 
-		group, err := zmsClient.GetGroup(dn, gn)
+		group, err := zmsClient.GetGroup(context.Background(), dn, gn)
 		if err == nil {
 			if group.GroupMembers != nil && len(group.GroupMembers) > 0 {
 				return fmt.Errorf("athenz Group Members still exists")
 			}
-			_ = zmsClient.DeleteGroup(dn, gn, AUDIT_REF)
+			_ = zmsClient.DeleteGroup(context.Background(), dn, gn, AUDIT_REF)
 		}
 	}
 
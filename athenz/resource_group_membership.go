@@ -0,0 +1,187 @@
+package athenz
+
+import (
+	"context"
+	"log"
+
+	"github.com/AthenZ/athenz/clients/go/zms"
+	"github.com/AthenZ/terraform-provider-athenz/client"
+	"github.com/ardielle/ardielle-go/rdl"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceGroupMembership() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGroupMembershipCreate,
+		ReadContext:   resourceGroupMembershipRead,
+		UpdateContext: resourceGroupMembershipUpdate,
+		DeleteContext: resourceGroupMembershipDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:             schema.TypeString,
+				Description:      "Name of the domain that group belongs to",
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validatePatternFunc(DOMAIN_NAME),
+			},
+			"group": {
+				Type:             schema.TypeString,
+				Description:      "Name of the group",
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validatePatternFunc(ENTITY_NAME),
+			},
+			"member": {
+				Type:             schema.TypeString,
+				Description:      "Athenz principal to be added as member of the group",
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validatePatternFunc(GROUP_MEMBER_NAME),
+			},
+			"expiration": {
+				Type:             schema.TypeString,
+				Description:      "Expiration of the membership, the group expiry settings apply when not set",
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validateDatePatternFunc(DATE_PATTERN, MEMBER_EXPIRATION),
+			},
+			"pending": {
+				Type:        schema.TypeBool,
+				Description: "Whether the membership is waiting for an approval",
+				Computed:    true,
+			},
+			"pending_state": {
+				Type:        schema.TypeString,
+				Description: "The pending request of the membership, ADD or DELETE",
+				Computed:    true,
+			},
+			"audit_ref": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  AUDIT_REF,
+			},
+		},
+	}
+}
+
+func resourceGroupMembershipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zmsClient := meta.(client.ZmsClient)
+	dn := d.Get("domain").(string)
+	gn := d.Get("group").(string)
+	member := d.Get("member").(string)
+
+	membership, err := zmsClient.GetGroupMembership(ctx, dn, gn, zms.GroupMemberName(member))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if membership.IsMember != nil && *membership.IsMember {
+		return diag.Errorf("the member %s already exists in the group %s of the domain %s, use terraform import command", member, gn, dn)
+	}
+	if diags := putGroupMembership(ctx, zmsClient, dn, gn, member, d); diags != nil {
+		return diags
+	}
+	d.SetId(dn + GROUP_SEPARATOR + gn + MEMBER_SEPARATOR + member)
+	return readAfterWrite(resourceGroupMembershipRead, ctx, d, meta)
+}
+
+func resourceGroupMembershipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zmsClient := meta.(client.ZmsClient)
+	dn, gn, member, err := splitGroupMembershipId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	membership, err := zmsClient.GetGroupMembership(ctx, dn, gn, zms.GroupMemberName(member))
+	switch v := err.(type) {
+	case rdl.ResourceError:
+		if v.Code == 404 && !d.IsNewResource() {
+			log.Printf("[WARN] Athenz Group %s not found, removing membership %s from state", dn+GROUP_SEPARATOR+gn, d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error retrieving Athenz Membership %s: %s", d.Id(), v)
+	case rdl.Any:
+		return diag.FromErr(err)
+	}
+	if membership.IsMember == nil || !*membership.IsMember {
+		if !d.IsNewResource() {
+			log.Printf("[WARN] Athenz Membership %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("the member %s was not added to the group %s of the domain %s", member, gn, dn)
+	}
+
+	if err = d.Set("domain", dn); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("group", gn); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("member", member); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("expiration", timestampToString(membership.Expiration)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("pending", membership.Approved != nil && !*membership.Approved); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("pending_state", membership.PendingState); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceGroupMembershipUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zmsClient := meta.(client.ZmsClient)
+	dn, gn, member, err := splitGroupMembershipId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if d.HasChange("expiration") {
+		if diags := putGroupMembership(ctx, zmsClient, dn, gn, member, d); diags != nil {
+			return diags
+		}
+	}
+	return readAfterWrite(resourceGroupMembershipRead, ctx, d, meta)
+}
+
+func resourceGroupMembershipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zmsClient := meta.(client.ZmsClient)
+	dn, gn, member, err := splitGroupMembershipId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	auditRef := d.Get("audit_ref").(string)
+	if d.Get("pending").(bool) {
+		err = zmsClient.DeletePendingGroupMembership(ctx, dn, gn, zms.GroupMemberName(member), auditRef)
+	} else {
+		err = zmsClient.DeleteGroupMembership(ctx, dn, gn, zms.GroupMemberName(member), auditRef)
+	}
+
+	switch v := err.(type) {
+	case rdl.ResourceError:
+		if v.Code == 404 {
+			return nil
+		}
+		return diag.FromErr(err)
+	case rdl.Any:
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func putGroupMembership(ctx context.Context, zmsClient client.ZmsClient, dn, gn, member string, d *schema.ResourceData) diag.Diagnostics {
+	membership := zms.GroupMembership{
+		MemberName: zms.GroupMemberName(member),
+		Expiration: stringToTimestamp(d.Get("expiration").(string)),
+	}
+	if err := zmsClient.PutGroupMembership(ctx, dn, gn, membership.MemberName, d.Get("audit_ref").(string), &membership); err != nil {
+		return diag.Errorf("error adding group member: %v", err)
+	}
+	return nil
+}
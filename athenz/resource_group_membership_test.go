@@ -0,0 +1,102 @@
+package athenz
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"testing"
+
+	"github.com/AthenZ/athenz/clients/go/zms"
+	"github.com/AthenZ/terraform-provider-athenz/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccGroupMembershipBasic(t *testing.T) {
+	if v := os.Getenv("TF_ACC"); v != "1" && v != "true" {
+		log.Print("TF_ACC must be set for acceptance tests")
+		return
+	}
+	if v := os.Getenv("DOMAIN"); v == "" {
+		t.Fatal("DOMAIN must be set for acceptance tests")
+	}
+	if v := os.Getenv("MEMBER_1"); v == "" {
+		t.Fatal("MEMBER_1 must be set for acceptance tests")
+	}
+	resourceName := "athenz_group_membership.membershipTest"
+	domainName := os.Getenv("DOMAIN")
+	groupName := fmt.Sprintf("test%d", acctest.RandInt())
+	member1 := os.Getenv("MEMBER_1")
+	if err := createTestGroupForMembers(domainName, groupName); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		cleanAllAccTestGroupMembers(domainName, []string{groupName})
+	})
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckGroupMembershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupMembershipConfig(domainName, groupName, member1, "2099-12-31 23:59:59"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupMembership(domainName, groupName, member1, true),
+					resource.TestCheckResourceAttr(resourceName, "member", member1),
+					resource.TestCheckResourceAttr(resourceName, "expiration", "2099-12-31 23:59:59"),
+					resource.TestCheckResourceAttr(resourceName, "pending", "false"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateId:           domainName + GROUP_SEPARATOR + groupName + MEMBER_SEPARATOR + member1,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"audit_ref"},
+			},
+		},
+	})
+}
+
+func testAccCheckGroupMembership(dn, gn, member string, isMember bool) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		zmsClient := testAccProvider.Meta().(client.ZmsClient)
+		membership, err := zmsClient.GetGroupMembership(context.Background(), dn, gn, zms.GroupMemberName(member))
+		if err != nil {
+			return err
+		}
+		if (membership.IsMember != nil && *membership.IsMember) != isMember {
+			return fmt.Errorf("unexpected membership of %s in group %s: %t", member, gn, !isMember)
+		}
+		return nil
+	}
+}
+
+func testAccCheckGroupMembershipDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "athenz_group_membership" {
+			continue
+		}
+		dn, gn, member, err := splitGroupMembershipId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if err = testAccCheckGroupMembership(dn, gn, member, false)(s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func testAccGroupMembershipConfig(domain, group, member, expiration string) string {
+	return fmt.Sprintf(`
+resource "athenz_group_membership" "membershipTest" {
+  domain = "%s"
+  group = "%s"
+  member = "%s"
+  expiration = "%s"
+}
+`, domain, group, member, expiration)
+}
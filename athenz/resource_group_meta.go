@@ -124,9 +124,9 @@ func ResourceGroupMeta() *schema.Resource {
 	}
 }
 
-func createNewGroupIfNecessary(zmsClient client.ZmsClient, dn, gn string) error {
+func createNewGroupIfNecessary(ctx context.Context, zmsClient client.ZmsClient, dn, gn string) error {
 	// if group exists already, we don't need to create it
-	_, err := zmsClient.GetGroup(dn, gn)
+	_, err := zmsClient.GetGroup(ctx, dn, gn)
 	if err == nil {
 		return nil
 	}
@@ -138,7 +138,7 @@ func createNewGroupIfNecessary(zmsClient client.ZmsClient, dn, gn string) error
 			group := zms.Group{
 				Name: zms.ResourceName(gn),
 			}
-			return zmsClient.PutGroup(dn, gn, AUDIT_REF, &group)
+			return zmsClient.PutGroup(ctx, dn, gn, AUDIT_REF, &group)
 		}
 	}
 	return err
@@ -153,14 +153,14 @@ func resourceGroupMetaCreate(ctx context.Context, d *schema.ResourceData, meta i
 	// if the group doesn't exist, we need to create it first
 	// but only if the object_state is set to create if necessary
 	if zmsClient.GetGroupMetaResourceState(d.Get("resource_state").(int), client.StateCreateIfNecessary) {
-		err := createNewGroupIfNecessary(zmsClient, dn, gn)
+		err := createNewGroupIfNecessary(ctx, zmsClient, dn, gn)
 		if err != nil {
 			return diag.FromErr(err)
 		}
 	}
 
 	// update our group meta data
-	resp := updateGroupMeta(zmsClient, dn, gn, d)
+	resp := updateGroupMeta(ctx, zmsClient, dn, gn, d)
 	if resp != nil {
 		return resp
 	}
@@ -169,9 +169,9 @@ func resourceGroupMetaCreate(ctx context.Context, d *schema.ResourceData, meta i
 	return readAfterWrite(resourceGroupMetaRead, ctx, d, meta)
 }
 
-func updateGroupMeta(zmsClient client.ZmsClient, dn, gn string, d *schema.ResourceData) diag.Diagnostics {
+func updateGroupMeta(ctx context.Context, zmsClient client.ZmsClient, dn, gn string, d *schema.ResourceData) diag.Diagnostics {
 
-	group, err := zmsClient.GetGroup(dn, gn)
+	group, err := zmsClient.GetGroup(ctx, dn, gn)
 	if err != nil {
 		return diag.Errorf("unable to fetch group %s in domain %s: %v", gn, dn, err)
 	}
@@ -227,14 +227,14 @@ func updateGroupMeta(zmsClient client.ZmsClient, dn, gn string, d *schema.Resour
 	auditEnabled := d.Get("audit_enabled").(bool)
 	groupMeta.AuditEnabled = &auditEnabled
 	auditRef := d.Get("audit_ref").(string)
-	err = zmsClient.PutGroupMeta(dn, gn, auditRef, &groupMeta)
+	err = zmsClient.PutGroupMeta(ctx, dn, gn, auditRef, &groupMeta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func resourceGroupMetaRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func resourceGroupMetaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	zmsClient := meta.(client.ZmsClient)
 
 	dn, gn, err := splitGroupId(d.Id())
@@ -247,7 +247,7 @@ func resourceGroupMetaRead(_ context.Context, d *schema.ResourceData, meta inter
 	if err = d.Set("name", gn); err != nil {
 		return diag.FromErr(err)
 	}
-	group, err := zmsClient.GetGroup(dn, gn)
+	group, err := zmsClient.GetGroup(ctx, dn, gn)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -308,14 +308,14 @@ func resourceGroupMetaUpdate(ctx context.Context, d *schema.ResourceData, meta i
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	resp := updateGroupMeta(zmsClient, dn, gn, d)
+	resp := updateGroupMeta(ctx, zmsClient, dn, gn, d)
 	if resp != nil {
 		return resp
 	}
 	return readAfterWrite(resourceGroupMetaRead, ctx, d, meta)
 }
 
-func resourceGroupMetaDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func resourceGroupMetaDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	zmsClient := meta.(client.ZmsClient)
 	dn, gn, err := splitGroupId(d.Id())
@@ -324,7 +324,7 @@ func resourceGroupMetaDelete(_ context.Context, d *schema.ResourceData, meta int
 	}
 	auditRef := d.Get("audit_ref").(string)
 	if zmsClient.GetGroupMetaResourceState(d.Get("resource_state").(int), client.StateAlwaysDelete) {
-		err = zmsClient.DeleteGroup(dn, gn, auditRef)
+		err = zmsClient.DeleteGroup(ctx, dn, gn, auditRef)
 	} else {
 		var zero int32
 		zero = 0
@@ -351,7 +351,7 @@ func resourceGroupMetaDelete(_ context.Context, d *schema.ResourceData, meta int
 				groupMeta.Tags[zms.TagKey(key)] = &zms.TagValueList{List: []zms.TagCompoundValue{}}
 			}
 		}
-		err = zmsClient.PutGroupMeta(dn, gn, auditRef, &groupMeta)
+		err = zmsClient.PutGroupMeta(ctx, dn, gn, auditRef, &groupMeta)
 	}
 	if err != nil {
 		return diag.FromErr(err)
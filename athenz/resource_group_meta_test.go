@@ -1,6 +1,7 @@
 package athenz
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"github.com/AthenZ/athenz/clients/go/zms"
@@ -59,7 +60,7 @@ func TestAccGroupMetaBasic(t *testing.T) {
 
 func cleanAccTestGroupMeta(domainName, groupName string) {
 	zmsClient := testAccProvider.Meta().(client.ZmsClient)
-	_, err := zmsClient.GetGroup(domainName, groupName)
+	_, err := zmsClient.GetGroup(context.Background(), domainName, groupName)
 	if err == nil {
 		var zero int32
 		zero = 0
@@ -81,7 +82,7 @@ func cleanAccTestGroupMeta(domainName, groupName string) {
 			AuditEnabled:            &disabled,
 			PrincipalDomainFilter:   "",
 		}
-		if err = zmsClient.PutGroupMeta(domainName, groupName, AUDIT_REF, &groupMeta); err != nil {
+		if err = zmsClient.PutGroupMeta(context.Background(), domainName, groupName, AUDIT_REF, &groupMeta); err != nil {
 			log.Printf("unable to reset group meta for %s:group.%s: %v\n", domainName, groupName, err)
 		}
 	}
@@ -102,7 +103,7 @@ func testAccCheckGroupMetaExists(resource string) resource.TestCheckFunc {
 			return err
 		}
 		zmsClient := testAccProvider.Meta().(client.ZmsClient)
-		group, err := zmsClient.GetGroup(dn, gn)
+		group, err := zmsClient.GetGroup(context.Background(), dn, gn)
 		if err != nil {
 			return err
 		}
@@ -127,7 +128,7 @@ func testAccCheckGroupMetaDestroy(s *terraform.State) error {
 		if err != nil {
 			return err
 		}
-		group, err := zmsClient.GetGroup(dn, gn)
+		group, err := zmsClient.GetGroup(context.Background(), dn, gn)
 		if err != nil {
 			return err
 		}
@@ -137,7 +138,7 @@ func testAccCheckGroupMetaDestroy(s *terraform.State) error {
 		if group.NotifyDetails != "" {
 			return fmt.Errorf("athenz group meta notify details still exists")
 		}
-		_ = zmsClient.DeleteGroup(dn, gn, AUDIT_REF)
+		_ = zmsClient.DeleteGroup(context.Background(), dn, gn, AUDIT_REF)
 	}
 
 	return nil
@@ -222,9 +223,9 @@ func testAccCheckGroupMetaResourceStateDeleteDestroy(s *terraform.State) error {
 			return err
 		}
 		// make sure our group is deleted and 404 is returned
-		_, err = zmsClient.GetGroup(dn, gn)
+		_, err = zmsClient.GetGroup(context.Background(), dn, gn)
 		if err == nil {
-			_ = zmsClient.DeleteGroup(dn, gn, AUDIT_REF)
+			_ = zmsClient.DeleteGroup(context.Background(), dn, gn, AUDIT_REF)
 			return fmt.Errorf("athenz group still exists")
 		}
 		var v rdl.ResourceError
@@ -1,6 +1,7 @@
 package athenz
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
@@ -478,9 +479,9 @@ func TestAccGroupInvalidResource(t *testing.T) {
 func cleanAllAccTestGroups(domain string, groups []string) {
 	zmsClient := testAccProvider.Meta().(client.ZmsClient)
 	for _, groupName := range groups {
-		_, err := zmsClient.GetGroup(domain, groupName)
+		_, err := zmsClient.GetGroup(context.Background(), domain, groupName)
 		if err == nil {
-			if err = zmsClient.DeleteGroup(domain, groupName, AUDIT_REF); err != nil {
+			if err = zmsClient.DeleteGroup(context.Background(), domain, groupName, AUDIT_REF); err != nil {
 				log.Printf("error deleting Group %s: %s", groupName, err)
 			}
 		}
@@ -502,7 +503,7 @@ func testAccCheckGroupExists(resourceName string, g *zms.Group) resource.TestChe
 		dn, gn := fullResourceName[0], fullResourceName[1]
 
 		zmsClient := testAccProvider.Meta().(client.ZmsClient)
-		group, err := zmsClient.GetGroup(dn, gn)
+		group, err := zmsClient.GetGroup(context.Background(), dn, gn)
 
 		if err != nil {
 			return err
@@ -524,7 +525,7 @@ func testAccCheckGroupDestroy(s *terraform.State) error {
 		fullResourceName := strings.Split(rs.Primary.ID, GROUP_SEPARATOR)
 		dn, gn := fullResourceName[0], fullResourceName[1]
 
-		_, err := zmsClient.GetGroup(dn, gn)
+		_, err := zmsClient.GetGroup(context.Background(), dn, gn)
 
 		if err == nil {
 			return fmt.Errorf("athenz Group still exists")
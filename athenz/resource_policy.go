@@ -89,7 +89,7 @@ func resourcePolicyRead(ctx context.Context, d *schema.ResourceData, meta interf
 	if err := d.Set("name", pn); err != nil {
 		return diag.FromErr(err)
 	}
-	policy, err := zmsClient.GetPolicy(dn, pn)
+	policy, err := zmsClient.GetPolicy(ctx, dn, pn)
 	switch v := err.(type) {
 	case rdl.ResourceError:
 		if v.Code == 404 {
@@ -139,7 +139,7 @@ func resourcePolicyCreate(ctx context.Context, d *schema.ResourceData, meta inte
 	dn := d.Get("domain").(string)
 	pn := d.Get("name").(string)
 	fullResourceName := dn + POLICY_SEPARATOR + pn
-	policyCheck, err := zmsClient.GetPolicy(dn, pn)
+	policyCheck, err := zmsClient.GetPolicy(ctx, dn, pn)
 	switch v := err.(type) {
 	case rdl.ResourceError:
 		if v.Code == 404 {
@@ -156,7 +156,7 @@ func resourcePolicyCreate(ctx context.Context, d *schema.ResourceData, meta inte
 				policy.Tags = expandTagsMap(v.(map[string]interface{}))
 			}
 			auditRef := d.Get("audit_ref").(string)
-			err = zmsClient.PutPolicy(dn, pn, auditRef, &policy)
+			err = zmsClient.PutPolicy(ctx, dn, pn, auditRef, &policy)
 			if err != nil {
 				return diag.FromErr(err)
 			}
@@ -184,7 +184,7 @@ func resourcePolicyUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		return diag.FromErr(err)
 	}
 
-	policy, err := zmsClient.GetPolicy(dn, pn)
+	policy, err := zmsClient.GetPolicy(ctx, dn, pn)
 	auditRef := d.Get("audit_ref").(string)
 	if err != nil {
 		return diag.Errorf("error retrieving Athenz Policy: %s", err)
@@ -203,7 +203,7 @@ func resourcePolicyUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		policy.Tags = expandTagsMap(n.(map[string]interface{}))
 	}
 
-	err = zmsClient.PutPolicy(dn, pn, auditRef, policy)
+	err = zmsClient.PutPolicy(ctx, dn, pn, auditRef, policy)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -219,7 +219,7 @@ func resourcePolicyDelete(ctx context.Context, d *schema.ResourceData, meta inte
 	}
 
 	auditRef := d.Get("audit_ref").(string)
-	err = zmsClient.DeletePolicy(dn, pn, auditRef)
+	err = zmsClient.DeletePolicy(ctx, dn, pn, auditRef)
 
 	switch v := err.(type) {
 	case rdl.ResourceError:
@@ -1,6 +1,7 @@
 package athenz
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
@@ -274,17 +275,17 @@ func TestAccGroupPolicyInvalidResource(t *testing.T) {
 func cleanAllAccTestPolicies(domain string, policies, roles []string) {
 	zmsClient := testAccProvider.Meta().(client.ZmsClient)
 	for _, policyName := range policies {
-		_, err := zmsClient.GetPolicy(domain, policyName)
+		_, err := zmsClient.GetPolicy(context.Background(), domain, policyName)
 		if err == nil {
-			if err = zmsClient.DeletePolicy(domain, policyName, AUDIT_REF); err != nil {
+			if err = zmsClient.DeletePolicy(context.Background(), domain, policyName, AUDIT_REF); err != nil {
 				log.Printf("error deleting Policy %s: %s", policyName, err)
 			}
 		}
 	}
 	for _, roleName := range roles {
-		_, err := zmsClient.GetRole(domain, roleName)
+		_, err := zmsClient.GetRole(context.Background(), domain, roleName)
 		if err == nil {
-			if err = zmsClient.DeleteRole(domain, roleName, AUDIT_REF); err != nil {
+			if err = zmsClient.DeleteRole(context.Background(), domain, roleName, AUDIT_REF); err != nil {
 				log.Printf("error deleting Role %s: %s", roleName, err)
 			}
 		}
@@ -306,7 +307,7 @@ func testAccCheckGroupPolicyExists(n string, p *zms.Policy) resource.TestCheckFu
 		dn, pn := fullResourceName[0], fullResourceName[1]
 
 		zmsClient := testAccProvider.Meta().(client.ZmsClient)
-		policy, err := zmsClient.GetPolicy(dn, pn)
+		policy, err := zmsClient.GetPolicy(context.Background(), dn, pn)
 
 		if err != nil {
 			return err
@@ -329,7 +330,7 @@ func testAccCheckGroupPolicyDestroy(s *terraform.State) error {
 		fullResourceName := strings.Split(rs.Primary.ID, POLICY_SEPARATOR)
 		dn, pn := fullResourceName[0], fullResourceName[1]
 
-		_, err := zmsClient.GetPolicy(dn, pn)
+		_, err := zmsClient.GetPolicy(context.Background(), dn, pn)
 
 		if err == nil {
 			return fmt.Errorf("athenz Policy still exists")
@@ -97,7 +97,7 @@ func resourcePolicyVersionRead(ctx context.Context, d *schema.ResourceData, meta
 	if err := d.Set("name", pn); err != nil {
 		return diag.FromErr(err)
 	}
-	policyVersionList, err := getAllPolicyVersions(zmsClient, dn, pn)
+	policyVersionList, err := getAllPolicyVersions(ctx, zmsClient, dn, pn)
 	switch v := err.(type) {
 	case rdl.ResourceError:
 		if v.Code == 404 {
@@ -135,7 +135,7 @@ func resourcePolicyVersionCreate(ctx context.Context, d *schema.ResourceData, me
 	dn := d.Get("domain").(string)
 	pn := d.Get("name").(string)
 	fullResourceName := dn + POLICY_SEPARATOR + pn
-	policyCheck, err := zmsClient.GetPolicy(dn, pn)
+	policyCheck, err := zmsClient.GetPolicy(ctx, dn, pn)
 	switch v := err.(type) {
 	case rdl.ResourceError:
 		if v.Code == 404 {
@@ -164,7 +164,7 @@ func resourcePolicyVersionCreate(ctx context.Context, d *schema.ResourceData, me
 			// must put the active version first
 			policyVersions[0], policyVersions[activeVersionIndex] = policyVersions[activeVersionIndex], policyVersions[0]
 			for _, policyVersion := range policyVersions {
-				if err := zmsClient.PutPolicy(dn, pn, auditRef, &policyVersion); err != nil {
+				if err := zmsClient.PutPolicy(ctx, dn, pn, auditRef, &policyVersion); err != nil {
 					return diag.FromErr(err)
 				}
 			}
@@ -202,7 +202,7 @@ func resourcePolicyVersionUpdate(ctx context.Context, d *schema.ResourceData, me
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	policyVersionList, err := getAllPolicyVersions(zmsClient, dn, pn)
+	policyVersionList, err := getAllPolicyVersions(ctx, zmsClient, dn, pn)
 	if err != nil {
 		return diag.Errorf("error retrieving Athenz Policy vrsions: %s", err)
 	}
@@ -231,7 +231,7 @@ func resourcePolicyVersionUpdate(ctx context.Context, d *schema.ResourceData, me
 				}
 				assertions := expandPolicyAssertions(dn, policyVersion["assertion"].(*schema.Set).List())
 				zmsPolicyVersion.Assertions = assertions
-				if err = zmsClient.PutPolicy(dn, pn, auditRef, zmsPolicyVersion); err != nil {
+				if err = zmsClient.PutPolicy(ctx, dn, pn, auditRef, zmsPolicyVersion); err != nil {
 					return diag.FromErr(err)
 				}
 			}
@@ -241,12 +241,12 @@ func resourcePolicyVersionUpdate(ctx context.Context, d *schema.ResourceData, me
 			policyOptions := zms.PolicyOptions{
 				Version: zms.SimpleName(activeVersion),
 			}
-			if err = zmsClient.SetActivePolicyVersion(dn, pn, &policyOptions, auditRef); err != nil {
+			if err = zmsClient.SetActivePolicyVersion(ctx, dn, pn, &policyOptions, auditRef); err != nil {
 				return diag.FromErr(err)
 			}
 		}
 		for _, versionName := range versionsToDelete {
-			if err = zmsClient.DeletePolicyVersion(dn, pn, versionName, auditRef); err != nil {
+			if err = zmsClient.DeletePolicyVersion(ctx, dn, pn, versionName, auditRef); err != nil {
 				return diag.Errorf("can't remove the policy:%s, version:%s. the error:%s", dn+POLICY_SEPARATOR+pn, versionName, err)
 			}
 		}
@@ -254,7 +254,7 @@ func resourcePolicyVersionUpdate(ctx context.Context, d *schema.ResourceData, me
 		policyOptions := zms.PolicyOptions{
 			Version: zms.SimpleName(activeVersion),
 		}
-		if err = zmsClient.SetActivePolicyVersion(dn, pn, &policyOptions, auditRef); err != nil {
+		if err = zmsClient.SetActivePolicyVersion(ctx, dn, pn, &policyOptions, auditRef); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -277,7 +277,7 @@ func resourcePolicyVersionDelete(ctx context.Context, d *schema.ResourceData, me
 		return diag.FromErr(err)
 	}
 	auditRef := d.Get("audit_ref").(string)
-	err = zmsClient.DeletePolicy(dn, pn, auditRef)
+	err = zmsClient.DeletePolicy(ctx, dn, pn, auditRef)
 
 	switch v := err.(type) {
 	case rdl.ResourceError:
@@ -291,8 +291,8 @@ func resourcePolicyVersionDelete(ctx context.Context, d *schema.ResourceData, me
 	return nil
 }
 
-func getAllPolicyVersions(zmsClient client.ZmsClient, domainName, policyName string) ([]*zms.Policy, error) {
-	policyList, err := zmsClient.GetPolicies(domainName, true, true)
+func getAllPolicyVersions(ctx context.Context, zmsClient client.ZmsClient, domainName, policyName string) ([]*zms.Policy, error) {
+	policyList, err := zmsClient.GetPolicies(ctx, domainName, true, true, "", "")
 	if err != nil {
 		return nil, err
 	}
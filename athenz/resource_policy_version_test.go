@@ -1,6 +1,7 @@
 package athenz
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
@@ -218,17 +219,17 @@ func TestAccGroupPolicyVersionCaseSensitive(t *testing.T) {
 func cleanAllAccTestPoliciesVersion(domain string, policies []string, roles []string) {
 	zmsClient := testAccProvider.Meta().(client.ZmsClient)
 	for _, policyName := range policies {
-		_, err := zmsClient.GetPolicy(domain, policyName)
+		_, err := zmsClient.GetPolicy(context.Background(), domain, policyName)
 		if err == nil {
-			if err = zmsClient.DeletePolicy(domain, policyName, AUDIT_REF); err != nil {
+			if err = zmsClient.DeletePolicy(context.Background(), domain, policyName, AUDIT_REF); err != nil {
 				log.Printf("error deleting Policy %s: %s", policyName, err)
 			}
 		}
 	}
 	for _, roleName := range roles {
-		_, err := zmsClient.GetRole(domain, roleName)
+		_, err := zmsClient.GetRole(context.Background(), domain, roleName)
 		if err == nil {
-			if err = zmsClient.DeleteRole(domain, roleName, AUDIT_REF); err != nil {
+			if err = zmsClient.DeleteRole(context.Background(), domain, roleName, AUDIT_REF); err != nil {
 				log.Printf("error deleting Role %s: %s", roleName, err)
 			}
 		}
@@ -251,7 +252,7 @@ func testAccCheckGroupPolicyVersionsExists(resourceName string, policyVersionNam
 
 		zmsClient := testAccProvider.Meta().(client.ZmsClient)
 		for _, versionName := range policyVersionNames {
-			_, err := zmsClient.GetPolicyVersion(dn, pn, versionName)
+			_, err := zmsClient.GetPolicyVersion(context.Background(), dn, pn, versionName)
 			if err != nil {
 				return err
 			}
@@ -271,7 +272,7 @@ func testAccCheckGroupPolicyVersionsDestroy(s *terraform.State) error {
 		fullResourceName := strings.Split(rs.Primary.ID, POLICY_SEPARATOR)
 		dn, pn := fullResourceName[0], fullResourceName[1]
 
-		_, err := zmsClient.GetPolicy(dn, pn)
+		_, err := zmsClient.GetPolicy(context.Background(), dn, pn)
 
 		if err == nil {
 			return fmt.Errorf("athenz Policy still exists")
@@ -75,6 +75,7 @@ func ResourceRole() *schema.Resource {
 					},
 				},
 			},
+			"pending_member": pendingRoleMemberSchema(),
 			"settings": {
 				Type:        schema.TypeSet,
 				Description: "Advanced settings",
@@ -236,7 +237,7 @@ func resourceRoleCreate(ctx context.Context, d *schema.ResourceData, meta interf
 	rn := d.Get("name").(string)
 	fullResourceName := dn + ROLE_SEPARATOR + rn
 
-	roleCheck, err := zmsClient.GetRole(dn, rn)
+	roleCheck, err := zmsClient.GetRole(ctx, dn, rn)
 	switch v := err.(type) {
 	case rdl.ResourceError:
 		if v.Code == 404 {
@@ -307,7 +308,7 @@ func resourceRoleCreate(ctx context.Context, d *schema.ResourceData, meta interf
 			}
 			auditEnabled := d.Get("audit_enabled").(bool)
 			role.AuditEnabled = &auditEnabled
-			err = zmsClient.PutRole(dn, rn, auditRef, &role)
+			err = zmsClient.PutRole(ctx, dn, rn, auditRef, &role)
 			if err != nil {
 				return diag.FromErr(err)
 			}
@@ -327,7 +328,7 @@ func resourceRoleCreate(ctx context.Context, d *schema.ResourceData, meta interf
 	return readAfterWrite(resourceRoleRead, ctx, d, meta)
 }
 
-func resourceRoleRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func resourceRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	zmsClient := meta.(client.ZmsClient)
 
 	dn, rn, err := splitRoleId(d.Id())
@@ -340,7 +341,7 @@ func resourceRoleRead(_ context.Context, d *schema.ResourceData, meta interface{
 	if err = d.Set("name", rn); err != nil {
 		return diag.FromErr(err)
 	}
-	role, err := zmsClient.GetRole(dn, rn)
+	role, err := zmsClient.GetRoleWithPendingMembers(ctx, dn, rn)
 	switch v := err.(type) {
 	case rdl.ResourceError:
 		if v.Code == 404 {
@@ -359,13 +360,17 @@ func resourceRoleRead(_ context.Context, d *schema.ResourceData, meta interface{
 	if role == nil {
 		return diag.Errorf("error retrieving Athenz Role - Make sure your cert/key are valid")
 	}
-	if len(role.RoleMembers) > 0 {
+	roleMembers, pendingMembers := splitPendingRoleMembers(role.RoleMembers, knownMemberNames(d))
+	if err = d.Set("pending_member", flattenPendingRoleMembers(pendingMembers)); err != nil {
+		return diag.FromErr(err)
+	}
+	if len(roleMembers) > 0 {
 		if _, ok := d.GetOk("members"); ok {
-			if err = d.Set("members", flattenDeprecatedRoleMembers(role.RoleMembers)); err != nil {
+			if err = d.Set("members", flattenDeprecatedRoleMembers(roleMembers)); err != nil {
 				return diag.FromErr(err)
 			}
 		} else {
-			if err = d.Set("member", flattenRoleMembers(role.RoleMembers)); err != nil {
+			if err = d.Set("member", flattenRoleMembers(roleMembers)); err != nil {
 				return diag.FromErr(err)
 			}
 		}
@@ -521,7 +526,7 @@ func resourceRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 	auditRef := d.Get("audit_ref").(string)
 
-	role, err := zmsClient.GetRole(dn, rn)
+	role, err := zmsClient.GetRole(ctx, dn, rn)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -632,7 +637,7 @@ func resourceRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 		role.AuditEnabled = &auditEnabled
 	}
 
-	err = zmsClient.PutRole(dn, rn, auditRef, role)
+	err = zmsClient.PutRole(ctx, dn, rn, auditRef, role)
 	if err != nil {
 		return diag.Errorf("error updating role: %s", err)
 	}
@@ -640,14 +645,14 @@ func resourceRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 	return readAfterWrite(resourceRoleRead, ctx, d, meta)
 }
 
-func resourceRoleDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func resourceRoleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	zmsClient := meta.(client.ZmsClient)
 	dn, rn, err := splitRoleId(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	auditRef := d.Get("audit_ref").(string)
-	err = zmsClient.DeleteRole(dn, rn, auditRef)
+	err = zmsClient.DeleteRole(ctx, dn, rn, auditRef)
 
 	switch v := err.(type) {
 	case rdl.ResourceError:
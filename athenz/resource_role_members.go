@@ -78,7 +78,7 @@ func resourceRoleMembersCreate(ctx context.Context, d *schema.ResourceData, meta
 	rn := d.Get("name").(string)
 	fullResourceName := dn + ROLE_SEPARATOR + rn
 
-	_, err := zmsClient.GetRole(dn, rn)
+	_, err := zmsClient.GetRole(ctx, dn, rn)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -92,7 +92,7 @@ func resourceRoleMembersCreate(ctx context.Context, d *schema.ResourceData, meta
 				Expiration:     member.Expiration,
 				ReviewReminder: member.ReviewReminder,
 			}
-			err = zmsClient.PutMembership(dn, rn, member.MemberName, auditRef, &membership)
+			err = zmsClient.PutMembership(ctx, dn, rn, member.MemberName, auditRef, &membership)
 			if err != nil {
 				return diag.Errorf("error adding role member: %v", err)
 			}
@@ -103,7 +103,7 @@ func resourceRoleMembersCreate(ctx context.Context, d *schema.ResourceData, meta
 	return readAfterWrite(resourceRoleMembersRead, ctx, d, meta)
 }
 
-func resourceRoleMembersRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func resourceRoleMembersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	zmsClient := meta.(client.ZmsClient)
 
 	dn, rn, err := splitRoleId(d.Id())
@@ -116,7 +116,7 @@ func resourceRoleMembersRead(_ context.Context, d *schema.ResourceData, meta int
 	if err = d.Set("name", rn); err != nil {
 		return diag.FromErr(err)
 	}
-	role, err := zmsClient.GetRole(dn, rn)
+	role, err := zmsClient.GetRole(ctx, dn, rn)
 	switch v := err.(type) {
 	case rdl.ResourceError:
 		if v.Code == 404 {
@@ -155,7 +155,7 @@ func resourceRoleMembersUpdate(ctx context.Context, d *schema.ResourceData, meta
 	membersToDelete := make([]*zms.RoleMember, 0)
 	membersToAdd := make([]*zms.RoleMember, 0)
 
-	_, err = zmsClient.GetRole(dn, rn)
+	_, err = zmsClient.GetRole(ctx, dn, rn)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -172,12 +172,12 @@ func resourceRoleMembersUpdate(ctx context.Context, d *schema.ResourceData, meta
 		membersToNotDelete.add(string(member.MemberName))
 	}
 
-	err = deleteRoleMembers(dn, rn, membersToDelete, auditRef, zmsClient, membersToNotDelete)
+	err = deleteRoleMembers(ctx, dn, rn, membersToDelete, auditRef, zmsClient, membersToNotDelete)
 	if err != nil {
 		return diag.Errorf("error updating role membership: %s", err)
 	}
 
-	err = addRoleMembers(dn, rn, membersToAdd, auditRef, zmsClient)
+	err = addRoleMembers(ctx, dn, rn, membersToAdd, auditRef, zmsClient)
 	if err != nil {
 		return diag.Errorf("error updating role membership: %s", err)
 	}
@@ -185,14 +185,14 @@ func resourceRoleMembersUpdate(ctx context.Context, d *schema.ResourceData, meta
 	return readAfterWrite(resourceRoleMembersRead, ctx, d, meta)
 }
 
-func resourceRoleMembersDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func resourceRoleMembersDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	zmsClient := meta.(client.ZmsClient)
 	dn, rn, err := splitRoleId(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	auditRef := d.Get("audit_ref").(string)
-	role, err := zmsClient.GetRole(dn, rn)
+	role, err := zmsClient.GetRole(ctx, dn, rn)
 	if err != nil {
 		switch v := err.(type) {
 		case rdl.ResourceError:
@@ -205,7 +205,7 @@ func resourceRoleMembersDelete(_ context.Context, d *schema.ResourceData, meta i
 		}
 	}
 	for _, member := range role.RoleMembers {
-		err = zmsClient.DeleteMembership(dn, rn, member.MemberName, auditRef)
+		err = zmsClient.DeleteMembership(ctx, dn, rn, member.MemberName, auditRef)
 		if err != nil {
 			return diag.FromErr(err)
 		}
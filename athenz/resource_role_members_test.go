@@ -1,6 +1,7 @@
 package athenz
 
 import (
+	"context"
 	"fmt"
 	"github.com/AthenZ/athenz/clients/go/zms"
 	"github.com/AthenZ/terraform-provider-athenz/client"
@@ -104,9 +105,9 @@ func TestAccRoleMembersBasic(t *testing.T) {
 func cleanAllAccTestRoleMembers(domain string, roles []string) {
 	zmsClient := testAccProvider.Meta().(client.ZmsClient)
 	for _, roleName := range roles {
-		_, err := zmsClient.GetRole(domain, roleName)
+		_, err := zmsClient.GetRole(context.Background(), domain, roleName)
 		if err == nil {
-			if err = zmsClient.DeleteRole(domain, roleName, AUDIT_REF); err != nil {
+			if err = zmsClient.DeleteRole(context.Background(), domain, roleName, AUDIT_REF); err != nil {
 				log.Printf("error deleting Role %s: %s", roleName, err)
 			}
 		}
@@ -118,7 +119,7 @@ func createTestRoleForMembers(dn, rn string) error {
 	role := zms.Role{
 		Name: zms.ResourceName(rn),
 	}
-	return zmsClient.PutRole(dn, rn, AUDIT_REF, &role)
+	return zmsClient.PutRole(context.Background(), dn, rn, AUDIT_REF, &role)
 }
 
 func testAccCheckRoleMembersExists(n string) resource.TestCheckFunc {
@@ -135,12 +136,12 @@ func testAccCheckRoleMembersExists(n string) resource.TestCheckFunc {
 		dn, rn := fullResourceName[0], fullResourceName[1]
 
 		zmsClient := testAccProvider.Meta().(client.ZmsClient)
-		_, err := zmsClient.GetRole(dn, rn)
+		_, err := zmsClient.GetRole(context.Background(), dn, rn)
 		if err != nil {
 			role := zms.Role{
 				Name: zms.ResourceName(rn),
 			}
-			_ = zmsClient.PutRole(dn, rn, AUDIT_REF, &role)
+			_ = zmsClient.PutRole(context.Background(), dn, rn, AUDIT_REF, &role)
 			return err
 		}
 
@@ -213,12 +214,12 @@ func testAccCheckRoleMembersDestroy(s *terraform.State) error {
 		fullResourceName := strings.Split(rs.Primary.ID, ROLE_SEPARATOR)
 		dn, rn := fullResourceName[0], fullResourceName[1]
 
-		role, err := zmsClient.GetRole(dn, rn)
+		role, err := zmsClient.GetRole(context.Background(), dn, rn)
 		if err == nil {
 			if role.RoleMembers != nil && len(role.RoleMembers) > 0 {
 				return fmt.Errorf("athenz Role Members still exists")
 			}
-			_ = zmsClient.DeleteRole(dn, rn, AUDIT_REF)
+			_ = zmsClient.DeleteRole(context.Background(), dn, rn, AUDIT_REF)
 		}
 	}
 
@@ -0,0 +1,198 @@
+package athenz
+
+import (
+	"context"
+	"log"
+
+	"github.com/AthenZ/athenz/clients/go/zms"
+	"github.com/AthenZ/terraform-provider-athenz/client"
+	"github.com/ardielle/ardielle-go/rdl"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceRoleMembership() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRoleMembershipCreate,
+		ReadContext:   resourceRoleMembershipRead,
+		UpdateContext: resourceRoleMembershipUpdate,
+		DeleteContext: resourceRoleMembershipDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:             schema.TypeString,
+				Description:      "Name of the domain that role belongs to",
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validatePatternFunc(DOMAIN_NAME),
+			},
+			"role": {
+				Type:             schema.TypeString,
+				Description:      "Name of the role",
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validatePatternFunc(ENTITY_NAME),
+			},
+			"member": {
+				Type:             schema.TypeString,
+				Description:      "Athenz principal to be added as member of the role",
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validatePatternFunc(MEMBER_NAME),
+			},
+			"expiration": {
+				Type:             schema.TypeString,
+				Description:      "Expiration of the membership, the role expiry settings apply when not set",
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validateDatePatternFunc(DATE_PATTERN, MEMBER_EXPIRATION),
+			},
+			"review": {
+				Type:             schema.TypeString,
+				Description:      "Review reminder of the membership, the role review settings apply when not set",
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validateDatePatternFunc(DATE_PATTERN, MEMBER_REVIEW_REMINDER),
+			},
+			"pending": {
+				Type:        schema.TypeBool,
+				Description: "Whether the membership is waiting for an approval",
+				Computed:    true,
+			},
+			"pending_state": {
+				Type:        schema.TypeString,
+				Description: "The pending request of the membership, ADD or DELETE",
+				Computed:    true,
+			},
+			"audit_ref": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  AUDIT_REF,
+			},
+		},
+	}
+}
+
+func resourceRoleMembershipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zmsClient := meta.(client.ZmsClient)
+	dn := d.Get("domain").(string)
+	rn := d.Get("role").(string)
+	member := d.Get("member").(string)
+
+	membership, err := zmsClient.GetMembership(ctx, dn, rn, zms.MemberName(member))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if membership.IsMember != nil && *membership.IsMember {
+		return diag.Errorf("the member %s already exists in the role %s of the domain %s, use terraform import command", member, rn, dn)
+	}
+	if diags := putRoleMembership(ctx, zmsClient, dn, rn, member, d); diags != nil {
+		return diags
+	}
+	d.SetId(dn + ROLE_SEPARATOR + rn + MEMBER_SEPARATOR + member)
+	return readAfterWrite(resourceRoleMembershipRead, ctx, d, meta)
+}
+
+func resourceRoleMembershipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zmsClient := meta.(client.ZmsClient)
+	dn, rn, member, err := splitRoleMembershipId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	membership, err := zmsClient.GetMembership(ctx, dn, rn, zms.MemberName(member))
+	switch v := err.(type) {
+	case rdl.ResourceError:
+		if v.Code == 404 && !d.IsNewResource() {
+			log.Printf("[WARN] Athenz Role %s not found, removing membership %s from state", dn+ROLE_SEPARATOR+rn, d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error retrieving Athenz Membership %s: %s", d.Id(), v)
+	case rdl.Any:
+		return diag.FromErr(err)
+	}
+	if membership.IsMember == nil || !*membership.IsMember {
+		if !d.IsNewResource() {
+			log.Printf("[WARN] Athenz Membership %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("the member %s was not added to the role %s of the domain %s", member, rn, dn)
+	}
+
+	if err = d.Set("domain", dn); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("role", rn); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("member", member); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("expiration", timestampToString(membership.Expiration)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("review", timestampToString(membership.ReviewReminder)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("pending", membership.Approved != nil && !*membership.Approved); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("pending_state", membership.PendingState); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceRoleMembershipUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zmsClient := meta.(client.ZmsClient)
+	dn, rn, member, err := splitRoleMembershipId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if d.HasChanges("expiration", "review") {
+		if diags := putRoleMembership(ctx, zmsClient, dn, rn, member, d); diags != nil {
+			return diags
+		}
+	}
+	return readAfterWrite(resourceRoleMembershipRead, ctx, d, meta)
+}
+
+func resourceRoleMembershipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zmsClient := meta.(client.ZmsClient)
+	dn, rn, member, err := splitRoleMembershipId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	auditRef := d.Get("audit_ref").(string)
+	if d.Get("pending").(bool) {
+		err = zmsClient.DeletePendingMembership(ctx, dn, rn, zms.MemberName(member), auditRef)
+	} else {
+		err = zmsClient.DeleteMembership(ctx, dn, rn, zms.MemberName(member), auditRef)
+	}
+
+	switch v := err.(type) {
+	case rdl.ResourceError:
+		if v.Code == 404 {
+			return nil
+		}
+		return diag.FromErr(err)
+	case rdl.Any:
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func putRoleMembership(ctx context.Context, zmsClient client.ZmsClient, dn, rn, member string, d *schema.ResourceData) diag.Diagnostics {
+	membership := zms.Membership{
+		MemberName:     zms.MemberName(member),
+		Expiration:     stringToTimestamp(d.Get("expiration").(string)),
+		ReviewReminder: stringToTimestamp(d.Get("review").(string)),
+	}
+	if err := zmsClient.PutMembership(ctx, dn, rn, membership.MemberName, d.Get("audit_ref").(string), &membership); err != nil {
+		return diag.Errorf("error adding role member: %v", err)
+	}
+	return nil
+}
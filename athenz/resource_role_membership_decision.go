@@ -0,0 +1,123 @@
+package athenz
+
+import (
+	"context"
+	"log"
+
+	"github.com/AthenZ/athenz/clients/go/zms"
+	"github.com/AthenZ/terraform-provider-athenz/client"
+	"github.com/ardielle/ardielle-go/rdl"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceRoleMembershipDecision approves or rejects a pending membership
+// request. The decision is final, so the resource has nothing to update and
+// destroying it only removes it from the state.
+func ResourceRoleMembershipDecision() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRoleMembershipDecisionCreate,
+		ReadContext:   resourceRoleMembershipDecisionRead,
+		DeleteContext: resourceRoleMembershipDecisionDelete,
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:             schema.TypeString,
+				Description:      "Name of the domain that role belongs to",
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validatePatternFunc(DOMAIN_NAME),
+			},
+			"role": {
+				Type:             schema.TypeString,
+				Description:      "Name of the role",
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validatePatternFunc(ENTITY_NAME),
+			},
+			"member": {
+				Type:             schema.TypeString,
+				Description:      "Athenz principal of the pending membership request",
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validatePatternFunc(MEMBER_NAME),
+			},
+			"approve": {
+				Type:        schema.TypeBool,
+				Description: "true to approve the request, false to reject it",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"audit_ref": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  AUDIT_REF,
+			},
+		},
+	}
+}
+
+func resourceRoleMembershipDecisionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zmsClient := meta.(client.ZmsClient)
+	dn := d.Get("domain").(string)
+	rn := d.Get("role").(string)
+	member := zms.MemberName(d.Get("member").(string))
+
+	role, err := zmsClient.GetRoleWithPendingMembers(ctx, dn, rn)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	pendingMember := findPendingRoleMember(role.RoleMembers, member)
+	if pendingMember == nil {
+		return diag.Errorf("the member %s has no pending request in the role %s of the domain %s", member, rn, dn)
+	}
+
+	approve := d.Get("approve").(bool)
+	membership := zms.Membership{
+		MemberName:     member,
+		RoleName:       zms.ResourceName(rn),
+		Expiration:     pendingMember.Expiration,
+		ReviewReminder: pendingMember.ReviewReminder,
+		Approved:       &approve,
+		PendingState:   pendingMember.PendingState,
+	}
+	if err = zmsClient.PutMembershipDecision(ctx, dn, rn, member, d.Get("audit_ref").(string), &membership); err != nil {
+		return diag.Errorf("error deciding on the membership of %s in role %s: %s", member, rn, err)
+	}
+	d.SetId(dn + ROLE_SEPARATOR + rn + MEMBER_SEPARATOR + string(member))
+	return resourceRoleMembershipDecisionRead(ctx, d, meta)
+}
+
+func resourceRoleMembershipDecisionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zmsClient := meta.(client.ZmsClient)
+	dn, rn, _, err := splitRoleMembershipId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	_, err = zmsClient.GetRole(ctx, dn, rn)
+	switch v := err.(type) {
+	case rdl.ResourceError:
+		if v.Code == 404 {
+			log.Printf("[WARN] Athenz Role %s not found, removing membership decision %s from state", dn+ROLE_SEPARATOR+rn, d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error retrieving Athenz Role %s: %s", dn+ROLE_SEPARATOR+rn, v)
+	case rdl.Any:
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceRoleMembershipDecisionDelete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return nil
+}
+
+func findPendingRoleMember(list []*zms.RoleMember, member zms.MemberName) *zms.RoleMember {
+	for _, m := range list {
+		if m.MemberName == member && m.Approved != nil && !*m.Approved {
+			return m
+		}
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package athenz
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AthenZ/athenz/clients/go/zms"
+	"github.com/AthenZ/terraform-provider-athenz/client"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ast "gotest.tools/assert"
+)
+
+func TestRoleMembershipDecisionCreate(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	clientMock := client.NewMockZmsClient(mockCtrl)
+	clientMock.EXPECT().GetRoleWithPendingMembers(gomock.Any(), "some_domain", "readers").Return(&zms.Role{Name: "some_domain:role.readers", RoleMembers: getTestPendingRoleMembers()}, nil).AnyTimes()
+	clientMock.EXPECT().GetRole(gomock.Any(), "some_domain", "readers").Return(&zms.Role{Name: "some_domain:role.readers"}, nil).AnyTimes()
+	clientMock.EXPECT().PutMembershipDecision(gomock.Any(), "some_domain", "readers", zms.MemberName("user.bob"), AUDIT_REF, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _, _ string, _ zms.MemberName, _ string, membership *zms.Membership) error {
+			ast.Equal(t, *membership.Approved, true)
+			ast.Equal(t, membership.PendingState, "ADD")
+			return nil
+		})
+
+	d := schema.TestResourceDataRaw(t, ResourceRoleMembershipDecision().Schema, map[string]interface{}{
+		"domain":  "some_domain",
+		"role":    "readers",
+		"member":  "user.bob",
+		"approve": true,
+	})
+	diags := resourceRoleMembershipDecisionCreate(context.Background(), d, clientMock)
+	ast.Assert(t, !diags.HasError(), diags)
+	ast.Equal(t, d.Id(), "some_domain:role.readers/user.bob")
+
+	// user.jane is already a member, there is nothing to decide
+	d = schema.TestResourceDataRaw(t, ResourceRoleMembershipDecision().Schema, map[string]interface{}{
+		"domain":  "some_domain",
+		"role":    "readers",
+		"member":  "user.jane",
+		"approve": false,
+	})
+	diags = resourceRoleMembershipDecisionCreate(context.Background(), d, clientMock)
+	ast.Assert(t, diags.HasError())
+	ast.Equal(t, diags[0].Summary, "the member user.jane has no pending request in the role readers of the domain some_domain")
+}
@@ -0,0 +1,122 @@
+package athenz
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"testing"
+
+	"github.com/AthenZ/athenz/clients/go/zms"
+	"github.com/AthenZ/terraform-provider-athenz/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccRoleMembershipBasic(t *testing.T) {
+	if v := os.Getenv("TF_ACC"); v != "1" && v != "true" {
+		log.Print("TF_ACC must be set for acceptance tests")
+		return
+	}
+	if v := os.Getenv("DOMAIN"); v == "" {
+		t.Fatal("DOMAIN must be set for acceptance tests")
+	}
+	if v := os.Getenv("MEMBER_1"); v == "" {
+		t.Fatal("MEMBER_1 must be set for acceptance tests")
+	}
+	if v := os.Getenv("MEMBER_2"); v == "" {
+		t.Fatal("MEMBER_2 must be set for acceptance tests")
+	}
+	resourceName := "athenz_role_membership.membershipTest"
+	domainName := os.Getenv("DOMAIN")
+	roleName := fmt.Sprintf("test%d", acctest.RandInt())
+	member1 := os.Getenv("MEMBER_1")
+	member2 := os.Getenv("MEMBER_2")
+	if err := createTestRoleForMembers(domainName, roleName); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		cleanAllAccTestRoleMembers(domainName, []string{roleName})
+	})
+	// a member added outside of terraform must survive the destroy
+	zmsClient := testAccProvider.Meta().(client.ZmsClient)
+	membership := zms.Membership{MemberName: zms.MemberName(member2)}
+	if err := zmsClient.PutMembership(context.Background(), domainName, roleName, membership.MemberName, AUDIT_REF, &membership); err != nil {
+		t.Fatal(err)
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckRoleMembershipDestroy,
+			testAccCheckRoleMembership(domainName, roleName, member2, true),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleMembershipConfig(domainName, roleName, member1, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleMembership(domainName, roleName, member1, true),
+					resource.TestCheckResourceAttr(resourceName, "member", member1),
+					resource.TestCheckResourceAttr(resourceName, "expiration", ""),
+					resource.TestCheckResourceAttr(resourceName, "pending", "false"),
+				),
+			},
+			{
+				Config: testAccRoleMembershipConfig(domainName, roleName, member1, "2099-12-31 23:59:59"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleMembership(domainName, roleName, member1, true),
+					resource.TestCheckResourceAttr(resourceName, "expiration", "2099-12-31 23:59:59"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateId:           domainName + ROLE_SEPARATOR + roleName + MEMBER_SEPARATOR + member1,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"audit_ref"},
+			},
+		},
+	})
+}
+
+func testAccCheckRoleMembership(dn, rn, member string, isMember bool) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		zmsClient := testAccProvider.Meta().(client.ZmsClient)
+		membership, err := zmsClient.GetMembership(context.Background(), dn, rn, zms.MemberName(member))
+		if err != nil {
+			return err
+		}
+		if (membership.IsMember != nil && *membership.IsMember) != isMember {
+			return fmt.Errorf("unexpected membership of %s in role %s: %t", member, rn, !isMember)
+		}
+		return nil
+	}
+}
+
+func testAccCheckRoleMembershipDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "athenz_role_membership" {
+			continue
+		}
+		dn, rn, member, err := splitRoleMembershipId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if err = testAccCheckRoleMembership(dn, rn, member, false)(s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func testAccRoleMembershipConfig(domain, role, member, expiration string) string {
+	return fmt.Sprintf(`
+resource "athenz_role_membership" "membershipTest" {
+  domain = "%s"
+  role = "%s"
+  member = "%s"
+  expiration = "%s"
+}
+`, domain, role, member, expiration)
+}
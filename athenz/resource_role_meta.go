@@ -162,9 +162,9 @@ func ResourceRoleMeta() *schema.Resource {
 	}
 }
 
-func createNewRoleIfNecessary(zmsClient client.ZmsClient, dn, rn string) error {
+func createNewRoleIfNecessary(ctx context.Context, zmsClient client.ZmsClient, dn, rn string) error {
 	// if role exists already, we don't need to create it
-	_, err := zmsClient.GetRole(dn, rn)
+	_, err := zmsClient.GetRole(ctx, dn, rn)
 	if err == nil {
 		return nil
 	}
@@ -176,7 +176,7 @@ func createNewRoleIfNecessary(zmsClient client.ZmsClient, dn, rn string) error {
 			role := zms.Role{
 				Name: zms.ResourceName(rn),
 			}
-			return zmsClient.PutRole(dn, rn, AUDIT_REF, &role)
+			return zmsClient.PutRole(ctx, dn, rn, AUDIT_REF, &role)
 		}
 	}
 	return err
@@ -191,14 +191,14 @@ func resourceRoleMetaCreate(ctx context.Context, d *schema.ResourceData, meta in
 	// if the role doesn't exist, we need to create it first
 	// but only if the object_state is set to create if necessary
 	if zmsClient.GetRoleMetaResourceState(d.Get("resource_state").(int), client.StateCreateIfNecessary) {
-		err := createNewRoleIfNecessary(zmsClient, dn, rn)
+		err := createNewRoleIfNecessary(ctx, zmsClient, dn, rn)
 		if err != nil {
 			return diag.FromErr(err)
 		}
 	}
 
 	// update our role meta data
-	resp := updateRoleMeta(zmsClient, dn, rn, d)
+	resp := updateRoleMeta(ctx, zmsClient, dn, rn, d)
 	if resp != nil {
 		return resp
 	}
@@ -207,9 +207,9 @@ func resourceRoleMetaCreate(ctx context.Context, d *schema.ResourceData, meta in
 	return readAfterWrite(resourceRoleMetaRead, ctx, d, meta)
 }
 
-func updateRoleMeta(zmsClient client.ZmsClient, dn, rn string, d *schema.ResourceData) diag.Diagnostics {
+func updateRoleMeta(ctx context.Context, zmsClient client.ZmsClient, dn, rn string, d *schema.ResourceData) diag.Diagnostics {
 
-	role, err := zmsClient.GetRole(dn, rn)
+	role, err := zmsClient.GetRole(ctx, dn, rn)
 	if err != nil {
 		return diag.Errorf("unable to fetch role %s in domain %s: %v", rn, dn, err)
 	}
@@ -299,14 +299,14 @@ func updateRoleMeta(zmsClient client.ZmsClient, dn, rn string, d *schema.Resourc
 	auditEnabled := d.Get("audit_enabled").(bool)
 	roleMeta.AuditEnabled = &auditEnabled
 	auditRef := d.Get("audit_ref").(string)
-	err = zmsClient.PutRoleMeta(dn, rn, auditRef, &roleMeta)
+	err = zmsClient.PutRoleMeta(ctx, dn, rn, auditRef, &roleMeta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func resourceRoleMetaRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func resourceRoleMetaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	zmsClient := meta.(client.ZmsClient)
 
 	dn, rn, err := splitRoleId(d.Id())
@@ -319,7 +319,7 @@ func resourceRoleMetaRead(_ context.Context, d *schema.ResourceData, meta interf
 	if err = d.Set("name", rn); err != nil {
 		return diag.FromErr(err)
 	}
-	role, err := zmsClient.GetRole(dn, rn)
+	role, err := zmsClient.GetRole(ctx, dn, rn)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -416,14 +416,14 @@ func resourceRoleMetaUpdate(ctx context.Context, d *schema.ResourceData, meta in
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	resp := updateRoleMeta(zmsClient, dn, rn, d)
+	resp := updateRoleMeta(ctx, zmsClient, dn, rn, d)
 	if resp != nil {
 		return resp
 	}
 	return readAfterWrite(resourceRoleMetaRead, ctx, d, meta)
 }
 
-func resourceRoleMetaDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func resourceRoleMetaDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	zmsClient := meta.(client.ZmsClient)
 	dn, rn, err := splitRoleId(d.Id())
@@ -432,7 +432,7 @@ func resourceRoleMetaDelete(_ context.Context, d *schema.ResourceData, meta inte
 	}
 	auditRef := d.Get("audit_ref").(string)
 	if zmsClient.GetRoleMetaResourceState(d.Get("resource_state").(int), client.StateAlwaysDelete) {
-		err = zmsClient.DeleteRole(dn, rn, auditRef)
+		err = zmsClient.DeleteRole(ctx, dn, rn, auditRef)
 	} else {
 		var zero int32
 		zero = 0
@@ -467,7 +467,7 @@ func resourceRoleMetaDelete(_ context.Context, d *schema.ResourceData, meta inte
 				roleMeta.Tags[zms.TagKey(key)] = &zms.TagValueList{List: []zms.TagCompoundValue{}}
 			}
 		}
-		err = zmsClient.PutRoleMeta(dn, rn, auditRef, &roleMeta)
+		err = zmsClient.PutRoleMeta(ctx, dn, rn, auditRef, &roleMeta)
 	}
 	if err != nil {
 		return diag.FromErr(err)
@@ -1,6 +1,7 @@
 package athenz
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"github.com/AthenZ/athenz/clients/go/zms"
@@ -66,7 +67,7 @@ func TestAccRoleMetaBasic(t *testing.T) {
 
 func cleanAccTestRoleMeta(domainName, roleName string) {
 	zmsClient := testAccProvider.Meta().(client.ZmsClient)
-	_, err := zmsClient.GetRole(domainName, roleName)
+	_, err := zmsClient.GetRole(context.Background(), domainName, roleName)
 	if err == nil {
 		var zero int32
 		zero = 0
@@ -96,7 +97,7 @@ func cleanAccTestRoleMeta(domainName, roleName string) {
 			AuditEnabled:            &disabled,
 			PrincipalDomainFilter:   "",
 		}
-		if err = zmsClient.PutRoleMeta(domainName, roleName, AUDIT_REF, &roleMeta); err != nil {
+		if err = zmsClient.PutRoleMeta(context.Background(), domainName, roleName, AUDIT_REF, &roleMeta); err != nil {
 			log.Printf("unable to reset role meta for %s:role.%s: %v\n", domainName, roleName, err)
 		}
 	}
@@ -117,7 +118,7 @@ func testAccCheckRoleMetaExists(resource string) resource.TestCheckFunc {
 			return err
 		}
 		zmsClient := testAccProvider.Meta().(client.ZmsClient)
-		role, err := zmsClient.GetRole(dn, rn)
+		role, err := zmsClient.GetRole(context.Background(), dn, rn)
 		if err != nil {
 			return err
 		}
@@ -139,14 +140,14 @@ func testAccCheckRoleMetaDestroy(s *terraform.State) error {
 		if err != nil {
 			return err
 		}
-		role, err := zmsClient.GetRole(dn, rn)
+		role, err := zmsClient.GetRole(context.Background(), dn, rn)
 		if err != nil {
 			return err
 		}
 		if role.Description != "" {
 			return fmt.Errorf("athenz role meta still exists")
 		}
-		_ = zmsClient.DeleteRole(dn, rn, AUDIT_REF)
+		_ = zmsClient.DeleteRole(context.Background(), dn, rn, AUDIT_REF)
 	}
 
 	return nil
@@ -245,9 +246,9 @@ func testAccCheckRoleMetaResourceStateDeleteDestroy(s *terraform.State) error {
 			return err
 		}
 		// make sure our role is deleted and 404 is returned
-		_, err = zmsClient.GetRole(dn, rn)
+		_, err = zmsClient.GetRole(context.Background(), dn, rn)
 		if err == nil {
-			_ = zmsClient.DeleteRole(dn, rn, AUDIT_REF)
+			_ = zmsClient.DeleteRole(context.Background(), dn, rn, AUDIT_REF)
 			return fmt.Errorf("athenz role still exists")
 		}
 		var v rdl.ResourceError
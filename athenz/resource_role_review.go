@@ -0,0 +1,231 @@
+package athenz
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/AthenZ/athenz/clients/go/zms"
+	"github.com/AthenZ/terraform-provider-athenz/client"
+	"github.com/ardielle/ardielle-go/rdl"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceRoleReview submits a review of a role: the reviewed members are
+// kept with their new expiration and the removed members are deleted. Every
+// change of the resource submits a new review, destroying it only removes it
+// from the state.
+func ResourceRoleReview() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRoleReviewCreate,
+		ReadContext:   resourceRoleReviewRead,
+		UpdateContext: resourceRoleReviewUpdate,
+		DeleteContext: resourceRoleReviewDelete,
+		CustomizeDiff: validateRoleReview,
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:             schema.TypeString,
+				Description:      "Name of the domain that role belongs to",
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validatePatternFunc(DOMAIN_NAME),
+			},
+			"role": {
+				Type:             schema.TypeString,
+				Description:      "Name of the reviewed role",
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validatePatternFunc(ENTITY_NAME),
+			},
+			"reviewer": {
+				Type:        schema.TypeString,
+				Description: "Athenz principal performing the review, recorded in the audit ref",
+				Required:    true,
+			},
+			"justification": {
+				Type:        schema.TypeString,
+				Description: "Justification of the review, recorded in the audit ref",
+				Required:    true,
+			},
+			"member": {
+				Type:        schema.TypeSet,
+				Description: "Members of the role to keep, they must be members of the role",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validatePatternFunc(MEMBER_NAME),
+						},
+						"expiration": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          "",
+							ValidateDiagFunc: validateDatePatternFunc(DATE_PATTERN, MEMBER_EXPIRATION),
+						},
+						"review": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          "",
+							ValidateDiagFunc: validateDatePatternFunc(DATE_PATTERN, MEMBER_REVIEW_REMINDER),
+						},
+					},
+				},
+			},
+			"remove": {
+				Type:        schema.TypeSet,
+				Description: "Members to remove from the role",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validatePatternFunc(MEMBER_NAME),
+				},
+				Set: schema.HashString,
+			},
+			"last_reviewed_date": {
+				Type:        schema.TypeString,
+				Description: "The last reviewed timestamp of the role",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceRoleReviewCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	dn := d.Get("domain").(string)
+	rn := d.Get("role").(string)
+	if diags := putRoleReview(ctx, meta.(client.ZmsClient), dn, rn, d); diags != nil {
+		return diags
+	}
+	d.SetId(dn + ROLE_SEPARATOR + rn)
+	return readAfterWrite(resourceRoleReviewRead, ctx, d, meta)
+}
+
+func resourceRoleReviewRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zmsClient := meta.(client.ZmsClient)
+	dn, rn, err := splitRoleId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	role, err := zmsClient.GetRole(ctx, dn, rn)
+	switch v := err.(type) {
+	case rdl.ResourceError:
+		if v.Code == 404 && !d.IsNewResource() {
+			log.Printf("[WARN] Athenz Role %s not found, removing review from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error retrieving Athenz Role %s: %s", d.Id(), v)
+	case rdl.Any:
+		return diag.FromErr(err)
+	}
+	if err = d.Set("last_reviewed_date", timestampToString(role.LastReviewedDate)); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceRoleReviewUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	dn, rn, err := splitRoleId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if diags := putRoleReview(ctx, meta.(client.ZmsClient), dn, rn, d); diags != nil {
+		return diags
+	}
+	return readAfterWrite(resourceRoleReviewRead, ctx, d, meta)
+}
+
+func resourceRoleReviewDelete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return nil
+}
+
+// validateRoleReview fails the plan when a member to keep is not a member of
+// the role, or when a member is both kept and removed.
+func validateRoleReview(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	keep := expandRoleMembers(d.Get("member").(*schema.Set).List())
+	remove := d.Get("remove").(*schema.Set)
+	for _, member := range keep {
+		if remove.Contains(string(member.MemberName)) {
+			return fmt.Errorf("the member %s can not be both kept and removed", member.MemberName)
+		}
+	}
+	if d.Id() != "" && !d.HasChange("member") {
+		return nil
+	}
+	if !d.NewValueKnown("domain") || !d.NewValueKnown("role") || len(keep) == 0 {
+		return nil
+	}
+	dn := d.Get("domain").(string)
+	rn := d.Get("role").(string)
+	role, err := meta.(client.ZmsClient).GetRole(ctx, dn, rn)
+	if err != nil {
+		return fmt.Errorf("error retrieving Athenz Role %s: %s", dn+ROLE_SEPARATOR+rn, err)
+	}
+	if missing := missingRoleMembers(role.RoleMembers, keep); len(missing) > 0 {
+		return fmt.Errorf("the members %s are not members of the role %s", strings.Join(missing, ", "), dn+ROLE_SEPARATOR+rn)
+	}
+	return nil
+}
+
+func putRoleReview(ctx context.Context, zmsClient client.ZmsClient, dn, rn string, d *schema.ResourceData) diag.Diagnostics {
+	role, err := zmsClient.GetRole(ctx, dn, rn)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	review := zms.Role{
+		Name:        zms.ResourceName(dn + ROLE_SEPARATOR + rn),
+		RoleMembers: expandRoleReviewMembers(d, role.RoleMembers),
+	}
+	auditRef := fmt.Sprintf("role review by %s: %s", d.Get("reviewer").(string), d.Get("justification").(string))
+	if err = zmsClient.PutRoleReview(ctx, dn, rn, auditRef, &review); err != nil {
+		return diag.Errorf("error reviewing role %s: %s", dn+ROLE_SEPARATOR+rn, err)
+	}
+	return nil
+}
+
+// expandRoleReviewMembers returns the members to keep as active members and
+// the members to remove as inactive ones. Members which already left the role
+// are not removed again.
+func expandRoleReviewMembers(d *schema.ResourceData, current []*zms.RoleMember) []*zms.RoleMember {
+	members := expandRoleMembers(d.Get("member").(*schema.Set).List())
+	for _, member := range members {
+		member.Active = boolPtr(true)
+	}
+	currentNames := stringSet{}
+	for _, member := range current {
+		currentNames.add(string(member.MemberName))
+	}
+	for _, name := range d.Get("remove").(*schema.Set).List() {
+		if currentNames.contains(name.(string)) {
+			member := zms.NewRoleMember()
+			member.MemberName = zms.MemberName(name.(string))
+			member.Active = boolPtr(false)
+			members = append(members, member)
+		}
+	}
+	return members
+}
+
+func missingRoleMembers(current []*zms.RoleMember, required []*zms.RoleMember) []string {
+	currentNames := stringSet{}
+	for _, member := range current {
+		currentNames.add(string(member.MemberName))
+	}
+	missing := make([]string, 0)
+	for _, member := range required {
+		if !currentNames.contains(string(member.MemberName)) {
+			missing = append(missing, string(member.MemberName))
+		}
+	}
+	sort.Strings(missing)
+	return missing
+}
+
+func boolPtr(value bool) *bool {
+	return &value
+}
@@ -0,0 +1,113 @@
+package athenz
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/AthenZ/athenz/clients/go/zms"
+	"github.com/AthenZ/terraform-provider-athenz/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ast "gotest.tools/assert"
+)
+
+func TestAccRoleReviewBasic(t *testing.T) {
+	if v := os.Getenv("TF_ACC"); v != "1" && v != "true" {
+		log.Print("TF_ACC must be set for acceptance tests")
+		return
+	}
+	if v := os.Getenv("DOMAIN"); v == "" {
+		t.Fatal("DOMAIN must be set for acceptance tests")
+	}
+	if v := os.Getenv("MEMBER_1"); v == "" {
+		t.Fatal("MEMBER_1 must be set for acceptance tests")
+	}
+	if v := os.Getenv("MEMBER_2"); v == "" {
+		t.Fatal("MEMBER_2 must be set for acceptance tests")
+	}
+	resourceName := "athenz_role_review.reviewTest"
+	domainName := os.Getenv("DOMAIN")
+	roleName := fmt.Sprintf("test%d", acctest.RandInt())
+	member1 := os.Getenv("MEMBER_1")
+	member2 := os.Getenv("MEMBER_2")
+	if err := createTestRoleForMembers(domainName, roleName); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		cleanAllAccTestRoleMembers(domainName, []string{roleName})
+	})
+	zmsClient := testAccProvider.Meta().(client.ZmsClient)
+	for _, member := range []string{member1, member2} {
+		membership := zms.Membership{MemberName: zms.MemberName(member)}
+		if err := zmsClient.PutMembership(context.Background(), domainName, roleName, membership.MemberName, AUDIT_REF, &membership); err != nil {
+			t.Fatal(err)
+		}
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRoleReviewConfig(domainName, roleName, "user.missing", member2),
+				ExpectError: regexp.MustCompile("the members user.missing are not members of the role"),
+			},
+			{
+				Config: testAccRoleReviewConfig(domainName, roleName, member1, member2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "last_reviewed_date"),
+					testAccCheckRoleMembership(domainName, roleName, member1, true),
+					testAccCheckRoleMembership(domainName, roleName, member2, false),
+				),
+			},
+		},
+	})
+}
+
+func testAccRoleReviewConfig(domain, role, keep, remove string) string {
+	return fmt.Sprintf(`
+resource "athenz_role_review" "reviewTest" {
+  domain = "%s"
+  role = "%s"
+  reviewer = "user.reviewer"
+  justification = "quarterly review"
+  member {
+    name = "%s"
+    expiration = "2099-12-31 23:59:59"
+  }
+  remove = ["%s"]
+}
+`, domain, role, keep, remove)
+}
+
+func TestExpandRoleReviewMembers(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceRoleReview().Schema, map[string]interface{}{
+		"domain":        "some_domain",
+		"role":          "readers",
+		"reviewer":      "user.reviewer",
+		"justification": "quarterly review",
+		"member": []interface{}{
+			map[string]interface{}{"name": "user.joe", "expiration": "2099-12-31 23:59:59"},
+		},
+		"remove": []interface{}{"user.jane", "user.gone"},
+	})
+	current := []*zms.RoleMember{{MemberName: "user.joe"}, {MemberName: "user.jane"}}
+	members := expandRoleReviewMembers(d, current)
+	ast.Equal(t, len(members), 2)
+	ast.Equal(t, string(members[0].MemberName), "user.joe")
+	ast.Equal(t, *members[0].Active, true)
+	ast.Equal(t, timestampToString(members[0].Expiration), "2099-12-31 23:59:59")
+	ast.Equal(t, string(members[1].MemberName), "user.jane")
+	ast.Equal(t, *members[1].Active, false)
+}
+
+func TestMissingRoleMembers(t *testing.T) {
+	current := []*zms.RoleMember{{MemberName: "user.joe"}, {MemberName: "user.jane"}}
+	required := []*zms.RoleMember{{MemberName: "user.joe"}, {MemberName: "user.zed"}, {MemberName: "user.bob"}}
+	ast.DeepEqual(t, missingRoleMembers(current, required), []string{"user.bob", "user.zed"})
+	ast.DeepEqual(t, missingRoleMembers(current, current), []string{})
+}
@@ -72,7 +72,7 @@ func resourceSelfServeGroupMembersCreate(ctx context.Context, d *schema.Resource
 	gn := d.Get("name").(string)
 	fullResourceName := dn + GROUP_SEPARATOR + gn
 
-	_, err := zmsClient.GetGroup(dn, gn)
+	_, err := zmsClient.GetGroup(ctx, dn, gn)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -85,7 +85,7 @@ func resourceSelfServeGroupMembersCreate(ctx context.Context, d *schema.Resource
 				MemberName: member.MemberName,
 				Expiration: member.Expiration,
 			}
-			err = zmsClient.PutGroupMembership(dn, gn, member.MemberName, auditRef, &membership)
+			err = zmsClient.PutGroupMembership(ctx, dn, gn, member.MemberName, auditRef, &membership)
 			if err != nil {
 				return diag.Errorf("error adding self-serve group member: %v", err)
 			}
@@ -96,7 +96,7 @@ func resourceSelfServeGroupMembersCreate(ctx context.Context, d *schema.Resource
 	return readAfterWrite(resourceSelfServeGroupMembersRead, ctx, d, meta)
 }
 
-func resourceSelfServeGroupMembersRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func resourceSelfServeGroupMembersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	zmsClient := meta.(client.ZmsClient)
 
 	dn, gn, err := splitGroupId(d.Id())
@@ -109,7 +109,7 @@ func resourceSelfServeGroupMembersRead(_ context.Context, d *schema.ResourceData
 	if err = d.Set("name", gn); err != nil {
 		return diag.FromErr(err)
 	}
-	_, err = zmsClient.GetGroup(dn, gn)
+	_, err = zmsClient.GetGroup(ctx, dn, gn)
 	switch v := err.(type) {
 	case rdl.ResourceError:
 		if v.Code == 404 {
@@ -151,7 +151,7 @@ func resourceSelfServeGroupMembersUpdate(ctx context.Context, d *schema.Resource
 	membersToDelete := make([]*zms.GroupMember, 0)
 	membersToAdd := make([]*zms.GroupMember, 0)
 
-	_, err = zmsClient.GetGroup(dn, gn)
+	_, err = zmsClient.GetGroup(ctx, dn, gn)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -162,7 +162,7 @@ func resourceSelfServeGroupMembersUpdate(ctx context.Context, d *schema.Resource
 		membersToAdd = append(membersToAdd, expandGroupMembers(ns.Difference(os).List())...)
 	}
 
-	err = updateGroupMembers(dn, gn, membersToDelete, membersToAdd, zmsClient, auditRef)
+	err = updateGroupMembers(ctx, dn, gn, membersToDelete, membersToAdd, zmsClient, auditRef)
 	if err != nil {
 		return diag.Errorf("error updating self-serve group membership: %s", err)
 	}
@@ -170,7 +170,7 @@ func resourceSelfServeGroupMembersUpdate(ctx context.Context, d *schema.Resource
 	return readAfterWrite(resourceSelfServeGroupMembersRead, ctx, d, meta)
 }
 
-func resourceSelfServeGroupMembersDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func resourceSelfServeGroupMembersDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	zmsClient := meta.(client.ZmsClient)
 	dn, gn, err := splitGroupId(d.Id())
 	if err != nil {
@@ -183,7 +183,7 @@ func resourceSelfServeGroupMembersDelete(_ context.Context, d *schema.ResourceDa
 	if v, ok := d.GetOk("member"); ok && v.(*schema.Set).Len() > 0 {
 		groupMembers := expandGroupMembers(v.(*schema.Set).List())
 		for _, member := range groupMembers {
-			err = zmsClient.DeleteGroupMembership(dn, gn, member.MemberName, auditRef)
+			err = zmsClient.DeleteGroupMembership(ctx, dn, gn, member.MemberName, auditRef)
 			if err != nil {
 				return diag.FromErr(err)
 			}
@@ -1,6 +1,7 @@
 package athenz
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
@@ -123,7 +124,7 @@ func TestAccSelfServeGroupMembersExternalMembers(t *testing.T) {
 	membership := zms.GroupMembership{
 		MemberName: zms.GroupMemberName(member2),
 	}
-	err = zmsClient.PutGroupMembership(domainName, groupName, zms.GroupMemberName(member2), AUDIT_REF, &membership)
+	err = zmsClient.PutGroupMembership(context.Background(), domainName, groupName, zms.GroupMemberName(member2), AUDIT_REF, &membership)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -155,9 +156,9 @@ func TestAccSelfServeGroupMembersExternalMembers(t *testing.T) {
 func cleanAllAccTestSelfServeGroupMembers(domain string, groups []string) {
 	zmsClient := testAccProvider.Meta().(client.ZmsClient)
 	for _, groupName := range groups {
-		_, err := zmsClient.GetGroup(domain, groupName)
+		_, err := zmsClient.GetGroup(context.Background(), domain, groupName)
 		if err == nil {
-			if err = zmsClient.DeleteGroup(domain, groupName, AUDIT_REF); err != nil {
+			if err = zmsClient.DeleteGroup(context.Background(), domain, groupName, AUDIT_REF); err != nil {
 				log.Printf("error deleting Group %s: %s", groupName, err)
 			}
 		}
@@ -169,7 +170,7 @@ func createTestSelfServeGroupForMembers(dn, gn string) error {
 	group := zms.Group{
 		Name: zms.ResourceName(gn),
 	}
-	return zmsClient.PutGroup(dn, gn, AUDIT_REF, &group)
+	return zmsClient.PutGroup(context.Background(), dn, gn, AUDIT_REF, &group)
 }
 
 func testAccCheckSelfServeGroupMembersExists(n string) resource.TestCheckFunc {
@@ -186,12 +187,12 @@ func testAccCheckSelfServeGroupMembersExists(n string) resource.TestCheckFunc {
 		dn, gn := fullResourceName[0], fullResourceName[1]
 
 		zmsClient := testAccProvider.Meta().(client.ZmsClient)
-		_, err := zmsClient.GetGroup(dn, gn)
+		_, err := zmsClient.GetGroup(context.Background(), dn, gn)
 		if err != nil {
 			group := zms.Group{
 				Name: zms.ResourceName(gn),
 			}
-			_ = zmsClient.PutGroup(dn, gn, AUDIT_REF, &group)
+			_ = zmsClient.PutGroup(context.Background(), dn, gn, AUDIT_REF, &group)
 			return err
 		}
 
@@ -202,7 +203,7 @@ func testAccCheckSelfServeGroupMembersExists(n string) resource.TestCheckFunc {
 func testAccCheckExternalGroupMemberStillExists(domain, group, member string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		zmsClient := testAccProvider.Meta().(client.ZmsClient)
-		groupData, err := zmsClient.GetGroup(domain, group)
+		groupData, err := zmsClient.GetGroup(context.Background(), domain, group)
 		if err != nil {
 			return err
 		}
@@ -281,12 +282,12 @@ func testAccCheckSelfServeGroupMembersDestroy(s *terraform.State) error {
 		fullResourceName := strings.Split(rs.Primary.ID, GROUP_SEPARATOR)
 		dn, gn := fullResourceName[0], fullResourceName[1]
 
-		group, err := zmsClient.GetGroup(dn, gn)
+		group, err := zmsClient.GetGroup(context.Background(), dn, gn)
 		if err == nil {
 			if len(group.GroupMembers) > 0 {
 				return fmt.Errorf("athenz Self Serve Group Members still exists")
 			}
-			_ = zmsClient.DeleteGroup(dn, gn, AUDIT_REF)
+			_ = zmsClient.DeleteGroup(context.Background(), dn, gn, AUDIT_REF)
 		}
 	}
 
@@ -304,12 +305,12 @@ func testAccCheckSelfServeGroupExternalMembersDestroy(s *terraform.State) error
 		fullResourceName := strings.Split(rs.Primary.ID, GROUP_SEPARATOR)
 		dn, gn := fullResourceName[0], fullResourceName[1]
 
-		group, err := zmsClient.GetGroup(dn, gn)
+		group, err := zmsClient.GetGroup(context.Background(), dn, gn)
 		if err == nil {
 			if len(group.GroupMembers) == 0 {
 				return fmt.Errorf("athenz ext group member should be present")
 			}
-			_ = zmsClient.DeleteGroup(dn, gn, AUDIT_REF)
+			_ = zmsClient.DeleteGroup(context.Background(), dn, gn, AUDIT_REF)
 		}
 	}
 
@@ -78,7 +78,7 @@ func resourceSelfServeRoleMembersCreate(ctx context.Context, d *schema.ResourceD
 	rn := d.Get("name").(string)
 	fullResourceName := dn + ROLE_SEPARATOR + rn
 
-	_, err := zmsClient.GetRole(dn, rn)
+	_, err := zmsClient.GetRole(ctx, dn, rn)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -92,7 +92,7 @@ func resourceSelfServeRoleMembersCreate(ctx context.Context, d *schema.ResourceD
 				Expiration:     member.Expiration,
 				ReviewReminder: member.ReviewReminder,
 			}
-			err = zmsClient.PutMembership(dn, rn, member.MemberName, auditRef, &membership)
+			err = zmsClient.PutMembership(ctx, dn, rn, member.MemberName, auditRef, &membership)
 			if err != nil {
 				return diag.Errorf("error adding self-serve role member: %v", err)
 			}
@@ -103,7 +103,7 @@ func resourceSelfServeRoleMembersCreate(ctx context.Context, d *schema.ResourceD
 	return readAfterWrite(resourceSelfServeRoleMembersRead, ctx, d, meta)
 }
 
-func resourceSelfServeRoleMembersRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func resourceSelfServeRoleMembersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	zmsClient := meta.(client.ZmsClient)
 
 	dn, rn, err := splitRoleId(d.Id())
@@ -116,7 +116,7 @@ func resourceSelfServeRoleMembersRead(_ context.Context, d *schema.ResourceData,
 	if err = d.Set("name", rn); err != nil {
 		return diag.FromErr(err)
 	}
-	_, err = zmsClient.GetRole(dn, rn)
+	_, err = zmsClient.GetRole(ctx, dn, rn)
 	switch v := err.(type) {
 	case rdl.ResourceError:
 		if v.Code == 404 {
@@ -158,7 +158,7 @@ func resourceSelfServeRoleMembersUpdate(ctx context.Context, d *schema.ResourceD
 	membersToDelete := make([]*zms.RoleMember, 0)
 	membersToAdd := make([]*zms.RoleMember, 0)
 
-	_, err = zmsClient.GetRole(dn, rn)
+	_, err = zmsClient.GetRole(ctx, dn, rn)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -175,12 +175,12 @@ func resourceSelfServeRoleMembersUpdate(ctx context.Context, d *schema.ResourceD
 		membersToNotDelete.add(string(member.MemberName))
 	}
 
-	err = deleteRoleMembers(dn, rn, membersToDelete, auditRef, zmsClient, membersToNotDelete)
+	err = deleteRoleMembers(ctx, dn, rn, membersToDelete, auditRef, zmsClient, membersToNotDelete)
 	if err != nil {
 		return diag.Errorf("error updating self-serve role membership: %s", err)
 	}
 
-	err = addRoleMembers(dn, rn, membersToAdd, auditRef, zmsClient)
+	err = addRoleMembers(ctx, dn, rn, membersToAdd, auditRef, zmsClient)
 	if err != nil {
 		return diag.Errorf("error updating self-serve role membership: %s", err)
 	}
@@ -188,7 +188,7 @@ func resourceSelfServeRoleMembersUpdate(ctx context.Context, d *schema.ResourceD
 	return readAfterWrite(resourceSelfServeRoleMembersRead, ctx, d, meta)
 }
 
-func resourceSelfServeRoleMembersDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func resourceSelfServeRoleMembersDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	zmsClient := meta.(client.ZmsClient)
 	dn, rn, err := splitRoleId(d.Id())
 	if err != nil {
@@ -201,7 +201,7 @@ func resourceSelfServeRoleMembersDelete(_ context.Context, d *schema.ResourceDat
 	if v, ok := d.GetOk("member"); ok && v.(*schema.Set).Len() > 0 {
 		roleMembers := expandRoleMembers(v.(*schema.Set).List())
 		for _, member := range roleMembers {
-			err = zmsClient.DeleteMembership(dn, rn, member.MemberName, auditRef)
+			err = zmsClient.DeleteMembership(ctx, dn, rn, member.MemberName, auditRef)
 			if err != nil {
 				return diag.FromErr(err)
 			}
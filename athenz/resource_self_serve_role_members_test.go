@@ -1,6 +1,7 @@
 package athenz
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
@@ -133,7 +134,7 @@ func TestAccSelfServeRoleMembersExternalMembers(t *testing.T) {
 	membership := zms.Membership{
 		MemberName: zms.MemberName(member2),
 	}
-	err = zmsClient.PutMembership(domainName, roleName, zms.MemberName(member2), AUDIT_REF, &membership)
+	err = zmsClient.PutMembership(context.Background(), domainName, roleName, zms.MemberName(member2), AUDIT_REF, &membership)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -165,9 +166,9 @@ func TestAccSelfServeRoleMembersExternalMembers(t *testing.T) {
 func cleanAllAccTestSelfServeRoleMembers(domain string, roles []string) {
 	zmsClient := testAccProvider.Meta().(client.ZmsClient)
 	for _, roleName := range roles {
-		_, err := zmsClient.GetRole(domain, roleName)
+		_, err := zmsClient.GetRole(context.Background(), domain, roleName)
 		if err == nil {
-			if err = zmsClient.DeleteRole(domain, roleName, AUDIT_REF); err != nil {
+			if err = zmsClient.DeleteRole(context.Background(), domain, roleName, AUDIT_REF); err != nil {
 				log.Printf("error deleting Role %s: %s", roleName, err)
 			}
 		}
@@ -179,7 +180,7 @@ func createTestSelfServeRoleForMembers(dn, rn string) error {
 	role := zms.Role{
 		Name: zms.ResourceName(rn),
 	}
-	return zmsClient.PutRole(dn, rn, AUDIT_REF, &role)
+	return zmsClient.PutRole(context.Background(), dn, rn, AUDIT_REF, &role)
 }
 
 func testAccCheckSelfServeRoleMembersExists(n string) resource.TestCheckFunc {
@@ -196,12 +197,12 @@ func testAccCheckSelfServeRoleMembersExists(n string) resource.TestCheckFunc {
 		dn, rn := fullResourceName[0], fullResourceName[1]
 
 		zmsClient := testAccProvider.Meta().(client.ZmsClient)
-		_, err := zmsClient.GetRole(dn, rn)
+		_, err := zmsClient.GetRole(context.Background(), dn, rn)
 		if err != nil {
 			role := zms.Role{
 				Name: zms.ResourceName(rn),
 			}
-			_ = zmsClient.PutRole(dn, rn, AUDIT_REF, &role)
+			_ = zmsClient.PutRole(context.Background(), dn, rn, AUDIT_REF, &role)
 			return err
 		}
 
@@ -212,7 +213,7 @@ func testAccCheckSelfServeRoleMembersExists(n string) resource.TestCheckFunc {
 func testAccCheckExternalMemberStillExists(domain, role, member string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		zmsClient := testAccProvider.Meta().(client.ZmsClient)
-		roleData, err := zmsClient.GetRole(domain, role)
+		roleData, err := zmsClient.GetRole(context.Background(), domain, role)
 		if err != nil {
 			return err
 		}
@@ -291,12 +292,12 @@ func testAccCheckSelfServeRoleMembersDestroy(s *terraform.State) error {
 		fullResourceName := strings.Split(rs.Primary.ID, ROLE_SEPARATOR)
 		dn, rn := fullResourceName[0], fullResourceName[1]
 
-		role, err := zmsClient.GetRole(dn, rn)
+		role, err := zmsClient.GetRole(context.Background(), dn, rn)
 		if err == nil {
 			if len(role.RoleMembers) > 0 {
 				return fmt.Errorf("athenz Self Serve Role Members still exists")
 			}
-			_ = zmsClient.DeleteRole(dn, rn, AUDIT_REF)
+			_ = zmsClient.DeleteRole(context.Background(), dn, rn, AUDIT_REF)
 		}
 	}
 
@@ -314,12 +315,12 @@ func testAccCheckSelfServeRoleExternalMembersDestroy(s *terraform.State) error {
 		fullResourceName := strings.Split(rs.Primary.ID, ROLE_SEPARATOR)
 		dn, rn := fullResourceName[0], fullResourceName[1]
 
-		role, err := zmsClient.GetRole(dn, rn)
+		role, err := zmsClient.GetRole(context.Background(), dn, rn)
 		if err == nil {
 			if len(role.RoleMembers) == 0 {
 				return fmt.Errorf("athenz ext role member should be present")
 			}
-			_ = zmsClient.DeleteRole(dn, rn, AUDIT_REF)
+			_ = zmsClient.DeleteRole(context.Background(), dn, rn, AUDIT_REF)
 		}
 	}
 
@@ -78,10 +78,65 @@ func ResourceService() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"executable": {
+				Type:        schema.TypeString,
+				Description: "Path to the executable that runs the service",
+				Optional:    true,
+			},
+			"user": {
+				Type:        schema.TypeString,
+				Description: "Local (unix) user name the service runs as",
+				Optional:    true,
+			},
+			"group": {
+				Type:        schema.TypeString,
+				Description: "Local (unix) group name the service runs as",
+				Optional:    true,
+			},
+			"provider_endpoint": {
+				Type:        schema.TypeString,
+				Description: "Callback endpoint of the service when it is a provider, set through the system meta API",
+				Optional:    true,
+			},
+			"x509_cert_signer_key_id": {
+				Type:        schema.TypeString,
+				Description: "Key id of the signer of the x509 certificates of the service, set through the system meta API",
+				Optional:    true,
+			},
+			"ssh_cert_signer_key_id": {
+				Type:        schema.TypeString,
+				Description: "Key id of the signer of the ssh certificates of the service, set through the system meta API",
+				Optional:    true,
+			},
+			"client_id": {
+				Type:        schema.TypeString,
+				Description: "OAuth2 client id of the service, set through the system meta API",
+				Optional:    true,
+			},
+			"creds": {
+				Type:        schema.TypeString,
+				Description: "Secret of the service, it is write only and never read back from ZMS",
+				Optional:    true,
+				Sensitive:   true,
+			},
 		},
 	}
 }
 
+// serviceSystemMetaFields maps the service attributes set through the system
+// meta API to the ZMS meta attribute and the matching fields.
+var serviceSystemMetaFields = []struct {
+	name      string
+	attribute string
+	meta      func(meta *zms.ServiceIdentitySystemMeta) *string
+	service   func(service *zms.ServiceIdentity) string
+}{
+	{"provider_endpoint", "providerendpoint", func(m *zms.ServiceIdentitySystemMeta) *string { return &m.ProviderEndpoint }, func(s *zms.ServiceIdentity) string { return s.ProviderEndpoint }},
+	{"x509_cert_signer_key_id", "x509certsignerkeyid", func(m *zms.ServiceIdentitySystemMeta) *string { return &m.X509CertSignerKeyId }, func(s *zms.ServiceIdentity) string { return s.X509CertSignerKeyId }},
+	{"ssh_cert_signer_key_id", "sshcertsignerkeyid", func(m *zms.ServiceIdentitySystemMeta) *string { return &m.SshCertSignerKeyId }, func(s *zms.ServiceIdentity) string { return s.SshCertSignerKeyId }},
+	{"client_id", "clientid", func(m *zms.ServiceIdentitySystemMeta) *string { return &m.ClientId }, func(s *zms.ServiceIdentity) string { return s.ClientId }},
+}
+
 func resourceServiceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	zmsClient := meta.(client.ZmsClient)
 
@@ -94,7 +149,7 @@ func resourceServiceCreate(ctx context.Context, d *schema.ResourceData, meta int
 	longName := domainName + SERVICE_SEPARATOR + shortName
 	publicKeyList := convertToPublicKeyEntryList(publicKeys)
 
-	serviceCheck, err := zmsClient.GetServiceIdentity(domainName, serviceName)
+	serviceCheck, err := zmsClient.GetServiceIdentity(ctx, domainName, serviceName)
 	switch v := err.(type) {
 	case rdl.ResourceError:
 		if v.Code == 404 {
@@ -102,6 +157,9 @@ func resourceServiceCreate(ctx context.Context, d *schema.ResourceData, meta int
 				Name:        zms.ServiceName(longName),
 				Description: description,
 				PublicKeys:  publicKeyList,
+				Executable:  d.Get("executable").(string),
+				User:        d.Get("user").(string),
+				Group:       d.Get("group").(string),
 			}
 			if v, ok := d.GetOk("hosts"); ok {
 				service.Hosts = expandStringSet(v.(*schema.Set))
@@ -109,10 +167,19 @@ func resourceServiceCreate(ctx context.Context, d *schema.ResourceData, meta int
 			if v, ok := d.GetOk("tags"); ok {
 				service.Tags = expandTagsMap(v.(map[string]interface{}))
 			}
-			err = zmsClient.PutServiceIdentity(domainName, shortName, auditRef, &service)
+			err = zmsClient.PutServiceIdentity(ctx, domainName, shortName, auditRef, &service)
 			if err != nil {
 				return diag.FromErr(err)
 			}
+			// the service exists from now on, a failure to set its meta or
+			// creds leaves it tainted in the state instead of orphaned
+			d.SetId(longName)
+			if diags := putServiceSystemMeta(ctx, zmsClient, domainName, shortName, auditRef, true, d); diags != nil {
+				return diags
+			}
+			if diags := putServiceCreds(ctx, zmsClient, domainName, shortName, auditRef, true, d); diags != nil {
+				return diags
+			}
 		} else {
 			return diag.FromErr(err)
 		}
@@ -143,7 +210,7 @@ func resourceServiceRead(ctx context.Context, d *schema.ResourceData, meta inter
 	if err = d.Set("name", serviceName); err != nil {
 		return diag.FromErr(err)
 	}
-	service, err := zmsClient.GetServiceIdentity(domainName, serviceName)
+	service, err := zmsClient.GetServiceIdentity(ctx, domainName, serviceName)
 
 	switch v := err.(type) {
 	case rdl.ResourceError:
@@ -188,6 +255,20 @@ func resourceServiceRead(ctx context.Context, d *schema.ResourceData, meta inter
 			return diag.FromErr(err)
 		}
 	}
+	if err = d.Set("executable", service.Executable); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("user", service.User); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("group", service.Group); err != nil {
+		return diag.FromErr(err)
+	}
+	for _, field := range serviceSystemMetaFields {
+		if err = d.Set(field.name, field.service(service)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 
 	return nil
 }
@@ -202,7 +283,7 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta int
 	description := d.Get("description").(string)
 	shortName := getShortName(domainName, serviceName, SERVICE_SEPARATOR)
 	auditRef := d.Get("audit_ref").(string)
-	service, err := zmsClient.GetServiceIdentity(domainName, serviceName)
+	service, err := zmsClient.GetServiceIdentity(ctx, domainName, serviceName)
 	if err != nil {
 		return diag.Errorf("error retrieving service %s: %s", d.Id(), err)
 	}
@@ -235,10 +316,20 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		service.Tags = expandTagsMap(n.(map[string]interface{}))
 	}
 
-	err = zmsClient.PutServiceIdentity(domainName, shortName, auditRef, service)
+	service.Executable = d.Get("executable").(string)
+	service.User = d.Get("user").(string)
+	service.Group = d.Get("group").(string)
+
+	err = zmsClient.PutServiceIdentity(ctx, domainName, shortName, auditRef, service)
 	if err != nil {
 		return diag.Errorf("error updating service membership: %s", err)
 	}
+	if diags := putServiceSystemMeta(ctx, zmsClient, domainName, shortName, auditRef, false, d); diags != nil {
+		return diags
+	}
+	if diags := putServiceCreds(ctx, zmsClient, domainName, shortName, auditRef, false, d); diags != nil {
+		return diags
+	}
 
 	return readAfterWrite(resourceServiceRead, ctx, d, meta)
 }
@@ -250,7 +341,7 @@ func resourceServiceDelete(ctx context.Context, d *schema.ResourceData, meta int
 		return diag.FromErr(err)
 	}
 	auditRef := d.Get("audit_ref").(string)
-	err = zmsClient.DeleteServiceIdentity(domainName, serviceName, auditRef)
+	err = zmsClient.DeleteServiceIdentity(ctx, domainName, serviceName, auditRef)
 
 	switch v := err.(type) {
 	case rdl.ResourceError:
@@ -263,3 +354,39 @@ func resourceServiceDelete(ctx context.Context, d *schema.ResourceData, meta int
 	}
 	return nil
 }
+
+// putServiceSystemMeta sets the system meta attributes of the service which
+// are configured on creation or changed on update, one attribute per call.
+func putServiceSystemMeta(ctx context.Context, zmsClient client.ZmsClient, domainName, serviceName, auditRef string, create bool, d *schema.ResourceData) diag.Diagnostics {
+	for _, field := range serviceSystemMetaFields {
+		value := d.Get(field.name).(string)
+		if create {
+			if value == "" {
+				continue
+			}
+		} else if !d.HasChange(field.name) {
+			continue
+		}
+		meta := zms.ServiceIdentitySystemMeta{}
+		*field.meta(&meta) = value
+		if err := zmsClient.PutServiceIdentitySystemMeta(ctx, domainName, serviceName, field.attribute, auditRef, &meta); err != nil {
+			return diag.Errorf("error updating %s of service %s: %s", field.name, domainName+SERVICE_SEPARATOR+serviceName, err)
+		}
+	}
+	return nil
+}
+
+func putServiceCreds(ctx context.Context, zmsClient client.ZmsClient, domainName, serviceName, auditRef string, create bool, d *schema.ResourceData) diag.Diagnostics {
+	creds := d.Get("creds").(string)
+	if create {
+		if creds == "" {
+			return nil
+		}
+	} else if !d.HasChange("creds") {
+		return nil
+	}
+	if err := zmsClient.PutServiceCredsEntry(ctx, domainName, serviceName, auditRef, &zms.CredsEntry{Value: creds}); err != nil {
+		return diag.Errorf("error updating creds of service %s: %s", domainName+SERVICE_SEPARATOR+serviceName, err)
+	}
+	return nil
+}
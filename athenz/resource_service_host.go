@@ -0,0 +1,189 @@
+package athenz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/AthenZ/terraform-provider-athenz/client"
+	"github.com/ardielle/ardielle-go/rdl"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// serviceHostMaxAttempts bounds the read-modify-write cycles of a host
+// registration when concurrent writers keep updating the same service.
+const serviceHostMaxAttempts = 5
+
+// ResourceServiceHost registers a single host on an existing service, so that
+// hosts can be managed apart from the athenz_service definition.
+func ResourceServiceHost() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceServiceHostCreate,
+		ReadContext:   resourceServiceHostRead,
+		DeleteContext: resourceServiceHostDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:             schema.TypeString,
+				Description:      "Name of the domain that service belongs to",
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validatePatternFunc(DOMAIN_NAME),
+			},
+			"service": {
+				Type:             schema.TypeString,
+				Description:      "Name of the service",
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validatePatternFunc(SIMPLE_NAME),
+			},
+			"host": {
+				Type:        schema.TypeString,
+				Description: "Name of the host to register on the service",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"audit_ref": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  AUDIT_REF,
+			},
+		},
+	}
+}
+
+func resourceServiceHostCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zmsClient := meta.(client.ZmsClient)
+	dn := d.Get("domain").(string)
+	sn := d.Get("service").(string)
+	host := d.Get("host").(string)
+
+	service, err := zmsClient.GetServiceIdentity(ctx, dn, sn)
+	if err != nil {
+		return diag.Errorf("error retrieving service %s: %s", dn+SERVICE_SEPARATOR+sn, err)
+	}
+	if containsString(service.Hosts, host) {
+		return diag.Errorf("the host %s already exists in the service %s of the domain %s, use terraform import command", host, sn, dn)
+	}
+	if err = updateServiceHosts(ctx, zmsClient, dn, sn, d.Get("audit_ref").(string), host, true); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(dn + SERVICE_SEPARATOR + sn + HOST_SEPARATOR + host)
+	return readAfterWrite(resourceServiceHostRead, ctx, d, meta)
+}
+
+func resourceServiceHostRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zmsClient := meta.(client.ZmsClient)
+	dn, sn, host, err := splitServiceHostId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	service, err := zmsClient.GetServiceIdentity(ctx, dn, sn)
+	switch v := err.(type) {
+	case rdl.ResourceError:
+		if v.Code == 404 && !d.IsNewResource() {
+			log.Printf("[WARN] Athenz Service %s not found, removing host %s from state", dn+SERVICE_SEPARATOR+sn, d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error retrieving Athenz Service %s: %s", dn+SERVICE_SEPARATOR+sn, v)
+	case rdl.Any:
+		return diag.FromErr(err)
+	}
+	if service == nil {
+		return diag.Errorf("error retrieving Athenz Service - Make sure your cert/key are valid")
+	}
+	if !containsString(service.Hosts, host) {
+		if !d.IsNewResource() {
+			log.Printf("[WARN] Athenz Service Host %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("the host %s was not added to the service %s of the domain %s", host, sn, dn)
+	}
+
+	if err = d.Set("domain", dn); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("service", sn); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("host", host); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceServiceHostDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zmsClient := meta.(client.ZmsClient)
+	dn, sn, host, err := splitServiceHostId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = updateServiceHosts(ctx, zmsClient, dn, sn, d.Get("audit_ref").(string), host, false)
+
+	var resourceErr rdl.ResourceError
+	if errors.As(err, &resourceErr) && resourceErr.Code == 404 {
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// updateServiceHosts adds or removes the host with a read-modify-write of the
+// service. ZMS replaces the whole host list on every update, so each write is
+// checked by reading the service again and the cycle is repeated when a
+// concurrent update conflicted with it or dropped the change.
+func updateServiceHosts(ctx context.Context, zmsClient client.ZmsClient, dn, sn, auditRef, host string, add bool) error {
+	for attempt := 0; ; attempt++ {
+		service, err := zmsClient.GetServiceIdentity(ctx, dn, sn)
+		if err != nil {
+			return err
+		}
+		if containsString(service.Hosts, host) == add {
+			return nil
+		}
+		if attempt == serviceHostMaxAttempts {
+			return fmt.Errorf("unable to update the host %s of service %s after %d attempts, the service is updated concurrently", host, dn+SERVICE_SEPARATOR+sn, serviceHostMaxAttempts)
+		}
+		service.Hosts = updateHostList(service.Hosts, host, add)
+		err = zmsClient.PutServiceIdentity(ctx, dn, sn, auditRef, service)
+		var resourceErr rdl.ResourceError
+		if errors.As(err, &resourceErr) && resourceErr.Code == 409 {
+			log.Printf("[WARN] conflict while updating the hosts of Athenz Service %s, retrying: %s", dn+SERVICE_SEPARATOR+sn, err)
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("error updating the hosts of service %s: %s", dn+SERVICE_SEPARATOR+sn, err)
+		}
+	}
+}
+
+func updateHostList(hosts []string, host string, add bool) []string {
+	result := make([]string, 0, len(hosts)+1)
+	for _, h := range hosts {
+		if h != host {
+			result = append(result, h)
+		}
+	}
+	if add {
+		result = append(result, host)
+	}
+	return result
+}
+
+func containsString(list []string, value string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
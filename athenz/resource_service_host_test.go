@@ -0,0 +1,156 @@
+package athenz
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"testing"
+
+	"github.com/AthenZ/athenz/clients/go/zms"
+	"github.com/AthenZ/terraform-provider-athenz/client"
+	"github.com/ardielle/ardielle-go/rdl"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ast "gotest.tools/assert"
+)
+
+func TestUpdateServiceHosts(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	clientMock := client.NewMockZmsClient(mockCtrl)
+	gomock.InOrder(
+		clientMock.EXPECT().GetServiceIdentity(gomock.Any(), "some_domain", "api").Return(&zms.ServiceIdentity{Name: "some_domain.api", Hosts: []string{"host1"}}, nil),
+		// a concurrent update conflicts with the first write
+		clientMock.EXPECT().PutServiceIdentity(gomock.Any(), "some_domain", "api", AUDIT_REF, gomock.Any()).Return(rdl.ResourceError{Code: 409, Message: "conflict"}),
+		clientMock.EXPECT().GetServiceIdentity(gomock.Any(), "some_domain", "api").Return(&zms.ServiceIdentity{Name: "some_domain.api", Hosts: []string{"host1", "host3"}}, nil),
+		clientMock.EXPECT().PutServiceIdentity(gomock.Any(), "some_domain", "api", AUDIT_REF, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _, _, _ string, service *zms.ServiceIdentity) error {
+				ast.DeepEqual(t, service.Hosts, []string{"host1", "host3", "host2"})
+				return nil
+			}),
+		clientMock.EXPECT().GetServiceIdentity(gomock.Any(), "some_domain", "api").Return(&zms.ServiceIdentity{Name: "some_domain.api", Hosts: []string{"host1", "host3", "host2"}}, nil),
+	)
+	ast.NilError(t, updateServiceHosts(context.Background(), clientMock, "some_domain", "api", AUDIT_REF, "host2", true))
+
+	// the host keeps being dropped by concurrent updates
+	clientMock.EXPECT().GetServiceIdentity(gomock.Any(), "some_domain", "web").DoAndReturn(
+		func(_ context.Context, _, _ string) (*zms.ServiceIdentity, error) {
+			return &zms.ServiceIdentity{Name: "some_domain.web", Hosts: []string{"host1"}}, nil
+		}).Times(serviceHostMaxAttempts + 1)
+	clientMock.EXPECT().PutServiceIdentity(gomock.Any(), "some_domain", "web", AUDIT_REF, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _, _, _ string, service *zms.ServiceIdentity) error {
+			ast.DeepEqual(t, service.Hosts, []string{})
+			return nil
+		}).Times(serviceHostMaxAttempts)
+	err := updateServiceHosts(context.Background(), clientMock, "some_domain", "web", AUDIT_REF, "host1", false)
+	ast.Error(t, err, fmt.Sprintf("unable to update the host host1 of service some_domain.web after %d attempts, the service is updated concurrently", serviceHostMaxAttempts))
+}
+
+func TestAccServiceHostBasic(t *testing.T) {
+	if v := os.Getenv("TF_ACC"); v != "1" && v != "true" {
+		log.Print("TF_ACC must be set for acceptance tests")
+		return
+	}
+	if v := os.Getenv("DOMAIN"); v == "" {
+		t.Fatal("DOMAIN must be set for acceptance tests")
+	}
+	resourceName := "athenz_service_host.hostTest"
+	dataSourceName := "data.athenz_host_services.hostServicesTest"
+	domain := os.Getenv("DOMAIN")
+	serviceName := fmt.Sprintf("test%d", acctest.RandInt())
+	host := fmt.Sprintf("host%d.example.com", acctest.RandInt())
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckServiceHostDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceHostConfig(domain, serviceName, host),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceHostExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "host", host),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "names.*", domain+SERVICE_SEPARATOR+serviceName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"audit_ref"},
+			},
+		},
+	})
+}
+
+func testAccCheckServiceHostExists(n string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no Athenz Service Host ID is set")
+		}
+		dn, sn, host, err := splitServiceHostId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		zmsClient := testAccProvider.Meta().(client.ZmsClient)
+		service, err := zmsClient.GetServiceIdentity(context.Background(), dn, sn)
+		if err != nil {
+			return err
+		}
+		if !containsString(service.Hosts, host) {
+			return fmt.Errorf("the host %s is not registered on the service %s", host, dn+SERVICE_SEPARATOR+sn)
+		}
+		return nil
+	}
+}
+
+func testAccCheckServiceHostDestroy(s *terraform.State) error {
+	zmsClient := testAccProvider.Meta().(client.ZmsClient)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "athenz_service_host" {
+			continue
+		}
+		dn, sn, host, err := splitServiceHostId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		service, err := zmsClient.GetServiceIdentity(context.Background(), dn, sn)
+		if v, ok := err.(rdl.ResourceError); ok && v.Code == 404 {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if containsString(service.Hosts, host) {
+			return fmt.Errorf("athenz Service Host still exists")
+		}
+	}
+	return nil
+}
+
+func testAccServiceHostConfig(domain, serviceName, host string) string {
+	return fmt.Sprintf(`
+resource "athenz_service" "serviceTest" {
+  domain = "%s"
+  name = "%s"
+  lifecycle {
+    ignore_changes = [hosts]
+  }
+}
+
+resource "athenz_service_host" "hostTest" {
+  domain = athenz_service.serviceTest.domain
+  service = athenz_service.serviceTest.name
+  host = "%s"
+}
+
+data "athenz_host_services" "hostServicesTest" {
+  host = athenz_service_host.hostTest.host
+}
+`, domain, serviceName, host)
+}
@@ -0,0 +1,167 @@
+package athenz
+
+import (
+	"context"
+	"log"
+
+	"github.com/AthenZ/athenz/clients/go/zms"
+	"github.com/AthenZ/terraform-provider-athenz/client"
+	"github.com/ardielle/ardielle-go/rdl"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceServicePublicKey manages a single public key of an existing service,
+// so that keys can be rotated without rewriting the service.
+func ResourceServicePublicKey() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceServicePublicKeyCreate,
+		ReadContext:   resourceServicePublicKeyRead,
+		UpdateContext: resourceServicePublicKeyUpdate,
+		DeleteContext: resourceServicePublicKeyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:             schema.TypeString,
+				Description:      "Name of the domain that service belongs to",
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validatePatternFunc(DOMAIN_NAME),
+			},
+			"service": {
+				Type:             schema.TypeString,
+				Description:      "Name of the service",
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validatePatternFunc(SIMPLE_NAME),
+			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Description: "Identifier of the public key",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"key_value": {
+				Type:             schema.TypeString,
+				Description:      "RSA or EC public key, either as PEM or ybase64 encoded PEM",
+				Required:         true,
+				StateFunc:        normalizePublicKeyValue,
+				ValidateDiagFunc: validatePublicKeyValue,
+			},
+			"audit_ref": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  AUDIT_REF,
+			},
+		},
+	}
+}
+
+func resourceServicePublicKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zmsClient := meta.(client.ZmsClient)
+	dn := d.Get("domain").(string)
+	sn := d.Get("service").(string)
+	keyId := d.Get("key_id").(string)
+
+	_, err := zmsClient.GetPublicKeyEntry(ctx, dn, sn, keyId)
+	switch v := err.(type) {
+	case rdl.ResourceError:
+		if v.Code != 404 {
+			return diag.FromErr(err)
+		}
+	case rdl.Any:
+		return diag.FromErr(err)
+	case nil:
+		return diag.Errorf("the public key %s already exists in the service %s of the domain %s, use terraform import command", keyId, sn, dn)
+	}
+	if diags := putServicePublicKey(ctx, zmsClient, dn, sn, keyId, d); diags != nil {
+		return diags
+	}
+	d.SetId(dn + SERVICE_SEPARATOR + sn + PUBLIC_KEY_SEPARATOR + keyId)
+	return readAfterWrite(resourceServicePublicKeyRead, ctx, d, meta)
+}
+
+func resourceServicePublicKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zmsClient := meta.(client.ZmsClient)
+	dn, sn, keyId, err := splitServicePublicKeyId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	publicKey, err := zmsClient.GetPublicKeyEntry(ctx, dn, sn, keyId)
+	switch v := err.(type) {
+	case rdl.ResourceError:
+		if v.Code == 404 && !d.IsNewResource() {
+			log.Printf("[WARN] Athenz Service Public Key %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error retrieving Athenz Service Public Key %s: %s", d.Id(), v)
+	case rdl.Any:
+		return diag.FromErr(err)
+	}
+	if publicKey == nil {
+		return diag.Errorf("error retrieving Athenz Service Public Key - Make sure your cert/key are valid")
+	}
+
+	if err = d.Set("domain", dn); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("service", sn); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("key_id", keyId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("key_value", normalizePublicKeyValue(convertToDecodedKey(publicKey.Key))); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceServicePublicKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zmsClient := meta.(client.ZmsClient)
+	dn, sn, keyId, err := splitServicePublicKeyId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if d.HasChange("key_value") {
+		if diags := putServicePublicKey(ctx, zmsClient, dn, sn, keyId, d); diags != nil {
+			return diags
+		}
+	}
+	return readAfterWrite(resourceServicePublicKeyRead, ctx, d, meta)
+}
+
+func resourceServicePublicKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zmsClient := meta.(client.ZmsClient)
+	dn, sn, keyId, err := splitServicePublicKeyId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	auditRef := d.Get("audit_ref").(string)
+	err = zmsClient.DeletePublicKeyEntry(ctx, dn, sn, keyId, auditRef)
+
+	switch v := err.(type) {
+	case rdl.ResourceError:
+		if v.Code == 404 {
+			return nil
+		}
+		return diag.FromErr(err)
+	case rdl.Any:
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func putServicePublicKey(ctx context.Context, zmsClient client.ZmsClient, dn, sn, keyId string, d *schema.ResourceData) diag.Diagnostics {
+	publicKey := zms.PublicKeyEntry{
+		Id:  keyId,
+		Key: convertToKeyBase64(normalizePublicKeyValue(d.Get("key_value"))),
+	}
+	if err := zmsClient.PutPublicKeyEntry(ctx, dn, sn, keyId, d.Get("audit_ref").(string), &publicKey); err != nil {
+		return diag.Errorf("error updating public key %s of service %s: %s", keyId, dn+SERVICE_SEPARATOR+sn, err)
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package athenz
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"testing"
+
+	"github.com/AthenZ/terraform-provider-athenz/client"
+	"github.com/ardielle/ardielle-go/rdl"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const testAccServicePublicKey = `-----BEGIN PUBLIC KEY-----
+MIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEAzZCUhLc3TpvObhjdY8Hb
+/0zkfWAYSXLXaC9O1S8AXoM7/L70XY+9KL+1Iy7xYDTrbZB0tcolLwnnWHq5giZm
+Uw3u6FGSl5ld4xpyqB02iK+cFSqS7KOLLH0p9gXRfxXiaqRiV2rKF0ThzrGox2cm
+Df/QoZllNdwIFGqkuRcEDvBnRTLWlEVV+1U12fyEsA1yvVb4F9RscZDYmiPRbhA+
+cLzqHKxX51dl6ek1x7AvUIM8js6WPIEfelyTRiUzXwOgIZbqvRHSPmFG0ZgZDjG3
+Llfy/E8K0QtCk3ki1y8Tga2I5k2hffx3DrHMnr14Zj3Br0T9RwiqJD7FoyTiD/ti
+xQIDAQAB
+-----END PUBLIC KEY-----
+`
+
+func TestAccServicePublicKeyBasic(t *testing.T) {
+	if v := os.Getenv("TF_ACC"); v != "1" && v != "true" {
+		log.Print("TF_ACC must be set for acceptance tests")
+		return
+	}
+	if v := os.Getenv("DOMAIN"); v == "" {
+		t.Fatal("DOMAIN must be set for acceptance tests")
+	}
+	resourceName := "athenz_service_public_key.keyTest"
+	domain := os.Getenv("DOMAIN")
+	serviceName := fmt.Sprintf("test%d", acctest.RandInt())
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckServicePublicKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServicePublicKeyConfig(domain, serviceName, testAccServicePublicKey),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServicePublicKeyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "key_id", "v0"),
+					resource.TestCheckResourceAttr(resourceName, "key_value", testAccServicePublicKey),
+				),
+			},
+			{
+				// the ybase64 form of the same key does not produce a diff
+				Config:   testAccServicePublicKeyConfig(domain, serviceName, convertToKeyBase64(testAccServicePublicKey)),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"audit_ref"},
+			},
+		},
+	})
+}
+
+func testAccCheckServicePublicKeyExists(n string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no Athenz Service Public Key ID is set")
+		}
+		dn, sn, keyId, err := splitServicePublicKeyId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		zmsClient := testAccProvider.Meta().(client.ZmsClient)
+		if _, err = zmsClient.GetPublicKeyEntry(context.Background(), dn, sn, keyId); err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccCheckServicePublicKeyDestroy(s *terraform.State) error {
+	zmsClient := testAccProvider.Meta().(client.ZmsClient)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "athenz_service_public_key" {
+			continue
+		}
+		dn, sn, keyId, err := splitServicePublicKeyId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		_, err = zmsClient.GetPublicKeyEntry(context.Background(), dn, sn, keyId)
+		if err == nil {
+			return fmt.Errorf("athenz Service Public Key still exists")
+		}
+		if v, ok := err.(rdl.ResourceError); !ok || v.Code != 404 {
+			return err
+		}
+	}
+	return nil
+}
+
+func testAccServicePublicKeyConfig(domain, serviceName, keyValue string) string {
+	return fmt.Sprintf(`
+resource "athenz_service" "serviceTest" {
+  domain = "%s"
+  name = "%s"
+  lifecycle {
+    ignore_changes = [public_keys]
+  }
+}
+
+resource "athenz_service_public_key" "keyTest" {
+  domain = athenz_service.serviceTest.domain
+  service = athenz_service.serviceTest.name
+  key_id = "v0"
+  key_value = %q
+}
+`, domain, serviceName, keyValue)
+}
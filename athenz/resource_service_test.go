@@ -1,6 +1,7 @@
 package athenz
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
@@ -8,9 +9,13 @@ import (
 
 	"github.com/AthenZ/athenz/clients/go/zms"
 	"github.com/AthenZ/terraform-provider-athenz/client"
+	"github.com/ardielle/ardielle-go/rdl"
+	"github.com/golang/mock/gomock"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ast "gotest.tools/assert"
 )
 
 func TestAccGroupServiceBasic(t *testing.T) {
@@ -104,10 +109,56 @@ func TestAccGroupServiceBasic(t *testing.T) {
 					resource.TestCheckTypeSetElemAttr(resourceName, "hosts.*", "host1.example.com"),
 				),
 			},
+			{
+				Config: testAccServiceConfigRunAs(serviceName, domain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupServiceExists(resourceName, &service),
+					resource.TestCheckResourceAttr(resourceName, "executable", "/usr/bin/api"),
+					resource.TestCheckResourceAttr(resourceName, "user", "api"),
+					resource.TestCheckResourceAttr(resourceName, "group", "apps"),
+				),
+			},
 		},
 	})
 }
 
+func TestPutServiceSystemMeta(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	clientMock := client.NewMockZmsClient(mockCtrl)
+	clientMock.EXPECT().PutServiceIdentitySystemMeta(gomock.Any(), "some_domain", "api", "providerendpoint", AUDIT_REF, &zms.ServiceIdentitySystemMeta{ProviderEndpoint: "https://provider.example.com"}).Return(nil)
+	clientMock.EXPECT().PutServiceIdentitySystemMeta(gomock.Any(), "some_domain", "api", "clientid", AUDIT_REF, &zms.ServiceIdentitySystemMeta{ClientId: "api-client"}).Return(nil)
+
+	// only the configured attributes are set on creation
+	d := schema.TestResourceDataRaw(t, ResourceService().Schema, map[string]interface{}{
+		"domain":            "some_domain",
+		"name":              "api",
+		"provider_endpoint": "https://provider.example.com",
+		"client_id":         "api-client",
+	})
+	diags := putServiceSystemMeta(context.Background(), clientMock, "some_domain", "api", AUDIT_REF, true, d)
+	ast.Assert(t, !diags.HasError(), diags)
+}
+
+func TestServiceCreateSystemMetaFailure(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	clientMock := client.NewMockZmsClient(mockCtrl)
+	gomock.InOrder(
+		clientMock.EXPECT().GetServiceIdentity(gomock.Any(), "some_domain", "api").Return(nil, rdl.ResourceError{Code: 404}),
+		clientMock.EXPECT().PutServiceIdentity(gomock.Any(), "some_domain", "api", AUDIT_REF, gomock.Any()).Return(nil),
+		clientMock.EXPECT().PutServiceIdentitySystemMeta(gomock.Any(), "some_domain", "api", "x509certsignerkeyid", AUDIT_REF, gomock.Any()).Return(rdl.ResourceError{Code: 403, Message: "Forbidden"}),
+	)
+
+	d := schema.TestResourceDataRaw(t, ResourceService().Schema, map[string]interface{}{
+		"domain":                  "some_domain",
+		"name":                    "api",
+		"x509_cert_signer_key_id": "signer",
+	})
+	diags := resourceServiceCreate(context.Background(), d, clientMock)
+	ast.Assert(t, diags.HasError())
+	// the service was created, it must be kept in the state
+	ast.Equal(t, d.Id(), "some_domain.api")
+}
+
 func TestAccGroupServiceInvalidResource(t *testing.T) {
 	if v := os.Getenv("TF_ACC"); v != "1" && v != "true" {
 		log.Printf("TF_ACC must be set for acceptance tests, value is: %s", v)
@@ -132,9 +183,9 @@ func TestAccGroupServiceInvalidResource(t *testing.T) {
 func cleanAllAccTestServices(domain string, services []string) {
 	zmsClient := testAccProvider.Meta().(client.ZmsClient)
 	for _, serviceName := range services {
-		_, err := zmsClient.GetServiceIdentity(domain, serviceName)
+		_, err := zmsClient.GetServiceIdentity(context.Background(), domain, serviceName)
 		if err == nil {
-			if err = zmsClient.DeleteServiceIdentity(domain, serviceName, AUDIT_REF); err != nil {
+			if err = zmsClient.DeleteServiceIdentity(context.Background(), domain, serviceName, AUDIT_REF); err != nil {
 				log.Printf("error deleting Service %s: %s", serviceName, err)
 			}
 		}
@@ -158,7 +209,7 @@ func testAccCheckGroupServiceExists(n string, s *zms.ServiceIdentity) resource.T
 		}
 
 		zmsClient := testAccProvider.Meta().(client.ZmsClient)
-		service, err := zmsClient.GetServiceIdentity(dn, sn)
+		service, err := zmsClient.GetServiceIdentity(context.Background(), dn, sn)
 
 		if err != nil {
 			return err
@@ -182,7 +233,7 @@ func testAccCheckGroupServiceDestroy(s *terraform.State) error {
 		if err != nil {
 			return err
 		}
-		_, err = zmsClient.GetServiceIdentity(dn, sn)
+		_, err = zmsClient.GetServiceIdentity(context.Background(), dn, sn)
 
 		if err == nil {
 			return fmt.Errorf("athenz Group still exists")
@@ -296,6 +347,19 @@ resource "athenz_service" "serviceTest" {
 `, name, domain)
 }
 
+func testAccServiceConfigRunAs(name, domain string) string {
+	return fmt.Sprintf(`
+resource "athenz_service" "serviceTest" {
+  name       = "%s"
+  domain     = "%s"
+  hosts      = ["host1.example.com"]
+  executable = "/usr/bin/api"
+  user       = "api"
+  group      = "apps"
+}
+`, name, domain)
+}
+
 func testAccServiceConfigRemoveHosts(name, domain string) string {
 	return fmt.Sprintf(`
 resource "athenz_service" "serviceTest" {
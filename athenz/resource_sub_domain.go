@@ -69,11 +69,11 @@ func resourceSubDomainCreate(ctx context.Context, d *schema.ResourceData, meta i
 		Parent:     zms.DomainName(parentDomainName),
 		AdminUsers: convertToZmsResourceNameList(adminUsers),
 	}
-	subDomainCheck, err := zmsClient.GetDomain(domainName)
+	subDomainCheck, err := zmsClient.GetDomain(ctx, domainName)
 	switch v := err.(type) {
 	case rdl.ResourceError:
 		if v.Code == 404 {
-			subDomain, err := zmsClient.PostSubDomain(parentDomainName, auditRef, &subDomainDetail)
+			subDomain, err := zmsClient.PostSubDomain(ctx, parentDomainName, auditRef, &subDomainDetail)
 			if err != nil {
 				return diag.FromErr(err)
 			}
@@ -103,7 +103,7 @@ func resourceSubDomainRead(ctx context.Context, d *schema.ResourceData, meta int
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	subDomain, err := zmsClient.GetDomain(fullyQualifiedName)
+	subDomain, err := zmsClient.GetDomain(ctx, fullyQualifiedName)
 	switch v := err.(type) {
 	case rdl.ResourceError:
 		if v.Code == 404 {
@@ -123,7 +123,7 @@ func resourceSubDomainRead(ctx context.Context, d *schema.ResourceData, meta int
 		return diag.Errorf("error retrieving Athenz Sub Domain - Make sure your cert/key are valid")
 	}
 
-	adminRole, err := zmsClient.GetRole(fullyQualifiedName, "admin")
+	adminRole, err := zmsClient.GetRole(ctx, fullyQualifiedName, "admin")
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -147,7 +147,7 @@ func resourceSubDomainDelete(ctx context.Context, d *schema.ResourceData, meta i
 		return diag.FromErr(err)
 	}
 	auditRef := d.Get("audit_ref").(string)
-	err = zmsClient.DeleteSubDomain(parentDomainName, subDomainName, auditRef)
+	err = zmsClient.DeleteSubDomain(ctx, parentDomainName, subDomainName, auditRef)
 	switch v := err.(type) {
 	case rdl.ResourceError:
 		if v.Code == 404 {
@@ -1,6 +1,7 @@
 package athenz
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
@@ -56,9 +57,9 @@ func TestAccGroupSubDomainBasic(t *testing.T) {
 func cleanAccTestSubDomain(parentName, domainName string) {
 	zmsClient := testAccProvider.Meta().(client.ZmsClient)
 	fullName := parentName + SUB_DOMAIN_SEPARATOR + domainName
-	_, err := zmsClient.GetDomain(fullName)
+	_, err := zmsClient.GetDomain(context.Background(), fullName)
 	if err == nil {
-		if err = zmsClient.DeleteSubDomain(parentName, domainName, AUDIT_REF); err != nil {
+		if err = zmsClient.DeleteSubDomain(context.Background(), parentName, domainName, AUDIT_REF); err != nil {
 			log.Fatalf("fail to delete Sub Domain %s. error: %s", fullName, err.Error())
 		}
 	}
@@ -76,7 +77,7 @@ func testAccCheckGroupSubDomainExists(resource string, d *zms.Domain) resource.T
 		}
 
 		zmsClient := testAccProvider.Meta().(client.ZmsClient)
-		domain, err := zmsClient.GetDomain(rs.Primary.ID)
+		domain, err := zmsClient.GetDomain(context.Background(), rs.Primary.ID)
 
 		if err != nil {
 			return err
@@ -94,7 +95,7 @@ func testAccCheckGroupSubDomainDestroy(s *terraform.State) error {
 			continue
 		}
 
-		_, err := zmsClient.GetDomain(rs.Primary.ID)
+		_, err := zmsClient.GetDomain(context.Background(), rs.Primary.ID)
 
 		if err == nil {
 			return fmt.Errorf("athenz Sub Domain still exists")
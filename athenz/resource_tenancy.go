@@ -0,0 +1,185 @@
+package athenz
+
+import (
+	"context"
+	"log"
+
+	"github.com/AthenZ/athenz/clients/go/zms"
+	"github.com/AthenZ/terraform-provider-athenz/client"
+	"github.com/ardielle/ardielle-go/rdl"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceTenancy registers a domain as a tenant of a provider service. ZMS
+// has no API to read a tenancy back, so the resource follows the tenancy admin
+// role and policy generated in the tenant domain.
+func ResourceTenancy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTenancyCreate,
+		ReadContext:   resourceTenancyRead,
+		DeleteContext: resourceTenancyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:             schema.TypeString,
+				Description:      "Name of the tenant domain",
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validatePatternFunc(DOMAIN_NAME),
+			},
+			"provider_service": {
+				Type:             schema.TypeString,
+				Description:      "Full name of the provider service, <provider_domain>.<service>",
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validatePatternFunc(SERVICE_NAME),
+			},
+			"audit_ref": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  AUDIT_REF,
+			},
+			"tenant_admin_role": {
+				Type:        schema.TypeString,
+				Description: "Full name of the tenancy admin role generated in the tenant domain",
+				Computed:    true,
+			},
+			"provider_admin_role": {
+				Type:        schema.TypeString,
+				Description: "Full name of the tenant admin role generated in the provider domain",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceTenancyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zmsClient := meta.(client.ZmsClient)
+	dn := d.Get("domain").(string)
+	provider := d.Get("provider_service").(string)
+
+	tenancy := zms.Tenancy{
+		Domain:  zms.DomainName(dn),
+		Service: zms.ServiceName(provider),
+	}
+	if err := zmsClient.PutTenancy(ctx, dn, provider, d.Get("audit_ref").(string), &tenancy); err != nil {
+		return diag.Errorf("error adding tenancy of domain %s for provider %s: %s", dn, provider, err)
+	}
+	d.SetId(dn + TENANCY_SEPARATOR + provider)
+	return readAfterWrite(resourceTenancyRead, ctx, d, meta)
+}
+
+func resourceTenancyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zmsClient := meta.(client.ZmsClient)
+	dn, provider, err := splitTenancyId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// ZMS generates the tenancy admin role and policy in the tenant domain and
+	// deletes both with the tenancy
+	adminRoleName := tenancyAdminRoleName(provider)
+	role, err := zmsClient.GetRole(ctx, dn, adminRoleName)
+	if diags, found := checkTenancyReadError(d, err, "role", dn+ROLE_SEPARATOR+adminRoleName); !found {
+		return diags
+	}
+	if role == nil {
+		return diag.Errorf("error retrieving Athenz tenancy role - Make sure your cert/key are valid")
+	}
+	_, err = zmsClient.GetPolicy(ctx, dn, adminRoleName)
+	if diags, found := checkTenancyReadError(d, err, "policy", dn+POLICY_SEPARATOR+adminRoleName); !found {
+		return diags
+	}
+
+	_, providerRole, err := tenancyAdminRoleNames(dn, provider)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("domain", dn); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("provider_service", provider); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("tenant_admin_role", string(role.Name)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("provider_admin_role", providerRole); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// checkTenancyReadError returns false when the tenancy object could not be
+// read, the tenancy is then removed from the state if it is not new.
+func checkTenancyReadError(d *schema.ResourceData, err error, kind, name string) (diag.Diagnostics, bool) {
+	switch v := err.(type) {
+	case rdl.ResourceError:
+		if v.Code == 404 && !d.IsNewResource() {
+			log.Printf("[WARN] Athenz tenancy %s %s not found, removing tenancy %s from state", kind, name, d.Id())
+			d.SetId("")
+			return nil, false
+		}
+		return diag.Errorf("error retrieving Athenz tenancy %s %s: %s", kind, name, v), false
+	case rdl.Any:
+		return diag.FromErr(err), false
+	}
+	return nil, true
+}
+
+func resourceTenancyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zmsClient := meta.(client.ZmsClient)
+	dn, provider, err := splitTenancyId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = zmsClient.DeleteTenancy(ctx, dn, provider, d.Get("audit_ref").(string))
+
+	switch v := err.(type) {
+	case rdl.ResourceError:
+		if v.Code == 404 {
+			return nil
+		}
+		return diag.FromErr(err)
+	case rdl.Any:
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// tenancyAdminRoleNames returns the full names of the admin roles ZMS
+// generates for a tenancy: tenancy.<provider>.admin in the tenant domain and
+// <service>.tenant.<tenant>.admin in the provider domain.
+func tenancyAdminRoleNames(tenant, provider string) (string, string, error) {
+	providerDomain, providerService, err := splitServiceId(provider)
+	if err != nil {
+		return "", "", err
+	}
+	tenantRole := tenant + ROLE_SEPARATOR + tenancyAdminRoleName(provider)
+	providerRole := providerDomain + ROLE_SEPARATOR + providerService + ".tenant." + tenant + ".admin"
+	return tenantRole, providerRole, nil
+}
+
+// tenancyAdminRoleName returns the short name of the admin role and policy ZMS
+// generates in the tenant domain.
+func tenancyAdminRoleName(provider string) string {
+	return "tenancy." + provider + ".admin"
+}
+
+// tenantResourceGroupRoleNames returns the full names of the roles ZMS
+// generates for a resource group role: <provider>.res_group.<group>.<role> in
+// the tenant domain and <service>.tenant.<tenant>.res_group.<group>.<role> in
+// the provider domain.
+func tenantResourceGroupRoleNames(tenant, provider, resourceGroup, role string) (string, string, error) {
+	providerDomain, providerService, err := splitServiceId(provider)
+	if err != nil {
+		return "", "", err
+	}
+	suffix := ".res_group." + resourceGroup + "." + role
+	tenantRole := tenant + ROLE_SEPARATOR + provider + suffix
+	providerRole := providerDomain + ROLE_SEPARATOR + providerService + ".tenant." + tenant + suffix
+	return tenantRole, providerRole, nil
+}
@@ -0,0 +1,67 @@
+package athenz
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AthenZ/athenz/clients/go/zms"
+	"github.com/AthenZ/terraform-provider-athenz/client"
+	"github.com/ardielle/ardielle-go/rdl"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ast "gotest.tools/assert"
+)
+
+func TestTenancyRoleNames(t *testing.T) {
+	tenantRole, providerRole, err := tenancyAdminRoleNames("sports", "sys.storage.api")
+	ast.NilError(t, err)
+	ast.Equal(t, tenantRole, "sports:role.tenancy.sys.storage.api.admin")
+	ast.Equal(t, providerRole, "sys.storage:role.api.tenant.sports.admin")
+
+	tenantRole, providerRole, err = tenantResourceGroupRoleNames("sports", "sys.storage.api", "hockey", "writer")
+	ast.NilError(t, err)
+	ast.Equal(t, tenantRole, "sports:role.sys.storage.api.res_group.hockey.writer")
+	ast.Equal(t, providerRole, "sys.storage:role.api.tenant.sports.res_group.hockey.writer")
+}
+
+func TestSplitTenancyIds(t *testing.T) {
+	dn, provider, err := splitTenancyId("sports" + TENANCY_SEPARATOR + "sys.storage.api")
+	ast.NilError(t, err)
+	ast.Equal(t, dn, "sports")
+	ast.Equal(t, provider, "sys.storage.api")
+
+	dn, provider, resourceGroup, err := splitTenantResourceGroupId("sports" + TENANCY_SEPARATOR + "sys.storage.api" + RESOURCE_GROUP_SEPARATOR + "hockey")
+	ast.NilError(t, err)
+	ast.Equal(t, dn, "sports")
+	ast.Equal(t, provider, "sys.storage.api")
+	ast.Equal(t, resourceGroup, "hockey")
+}
+
+func TestTenancyRead(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	clientMock := client.NewMockZmsClient(mockCtrl)
+	id := "sports" + TENANCY_SEPARATOR + "sys.storage.api"
+	gomock.InOrder(
+		clientMock.EXPECT().GetRole(gomock.Any(), "sports", "tenancy.sys.storage.api.admin").Return(&zms.Role{Name: "sports:role.tenancy.sys.storage.api.admin"}, nil),
+		clientMock.EXPECT().GetPolicy(gomock.Any(), "sports", "tenancy.sys.storage.api.admin").Return(&zms.Policy{Name: "sports:policy.tenancy.sys.storage.api.admin"}, nil),
+		// the tenancy was deleted out of band
+		clientMock.EXPECT().GetRole(gomock.Any(), "sports", "tenancy.sys.storage.api.admin").Return(nil, rdl.ResourceError{Code: 404}),
+		clientMock.EXPECT().GetRole(gomock.Any(), "sports", "tenancy.sys.storage.api.admin").Return(&zms.Role{Name: "sports:role.tenancy.sys.storage.api.admin"}, nil),
+		clientMock.EXPECT().GetPolicy(gomock.Any(), "sports", "tenancy.sys.storage.api.admin").Return(nil, rdl.ResourceError{Code: 404}),
+	)
+
+	d := ResourceTenancy().Data(&terraform.InstanceState{ID: id})
+	diags := resourceTenancyRead(context.Background(), d, clientMock)
+	ast.Assert(t, !diags.HasError(), diags)
+	ast.Equal(t, d.Id(), id)
+	ast.Equal(t, d.Get("domain"), "sports")
+	ast.Equal(t, d.Get("tenant_admin_role"), "sports:role.tenancy.sys.storage.api.admin")
+	ast.Equal(t, d.Get("provider_admin_role"), "sys.storage:role.api.tenant.sports.admin")
+
+	for _, missing := range []string{"role", "policy"} {
+		d = ResourceTenancy().Data(&terraform.InstanceState{ID: id})
+		diags = resourceTenancyRead(context.Background(), d, clientMock)
+		ast.Assert(t, !diags.HasError(), diags)
+		ast.Equal(t, d.Id(), "", "tenancy %s deleted", missing)
+	}
+}
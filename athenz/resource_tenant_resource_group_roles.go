@@ -0,0 +1,238 @@
+package athenz
+
+import (
+	"context"
+	"log"
+
+	"github.com/AthenZ/athenz/clients/go/zms"
+	"github.com/AthenZ/terraform-provider-athenz/client"
+	"github.com/ardielle/ardielle-go/rdl"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceTenantResourceGroupRoles provisions the roles of a tenant resource
+// group of a provider service, in both the tenant and the provider domain.
+func ResourceTenantResourceGroupRoles() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTenantResourceGroupRolesCreate,
+		ReadContext:   resourceTenantResourceGroupRolesRead,
+		DeleteContext: resourceTenantResourceGroupRolesDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:             schema.TypeString,
+				Description:      "Name of the tenant domain",
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validatePatternFunc(DOMAIN_NAME),
+			},
+			"provider_service": {
+				Type:             schema.TypeString,
+				Description:      "Full name of the provider service, <provider_domain>.<service>",
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validatePatternFunc(SERVICE_NAME),
+			},
+			"resource_group": {
+				Type:             schema.TypeString,
+				Description:      "Name of the tenant resource group",
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validatePatternFunc(ENTITY_NAME),
+			},
+			"role": {
+				Type:        schema.TypeSet,
+				Description: "Roles to provision with the action they are allowed",
+				Required:    true,
+				ForceNew:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:             schema.TypeString,
+							Description:      "Name of the role",
+							Required:         true,
+							ValidateDiagFunc: validatePatternFunc(SIMPLE_NAME),
+						},
+						"action": {
+							Type:        schema.TypeString,
+							Description: "Action allowed to the role",
+							Required:    true,
+						},
+					},
+				},
+			},
+			"create_admin_role": {
+				Type:        schema.TypeBool,
+				Description: "Whether to create the tenancy admin role of the resource group",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+			},
+			"skip_principal_member": {
+				Type:        schema.TypeBool,
+				Description: "Whether to skip adding the caller principal as member of the generated roles",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+			},
+			"audit_ref": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  AUDIT_REF,
+			},
+			"tenant_roles": {
+				Type:        schema.TypeMap,
+				Description: "Full names of the roles generated in the tenant domain, by role name",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"provider_roles": {
+				Type:        schema.TypeMap,
+				Description: "Full names of the roles generated in the provider domain, by role name",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceTenantResourceGroupRolesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zmsClient := meta.(client.ZmsClient)
+	dn := d.Get("domain").(string)
+	provider := d.Get("provider_service").(string)
+	resourceGroup := d.Get("resource_group").(string)
+	providerDomain, providerService, err := splitServiceId(provider)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	createAdminRole := d.Get("create_admin_role").(bool)
+	skipPrincipalMember := d.Get("skip_principal_member").(bool)
+	roles := zms.ProviderResourceGroupRoles{
+		Domain:              zms.DomainName(providerDomain),
+		Service:             zms.SimpleName(providerService),
+		Tenant:              zms.DomainName(dn),
+		Roles:               expandTenantRoleActions(d.Get("role").(*schema.Set).List()),
+		ResourceGroup:       zms.EntityName(resourceGroup),
+		CreateAdminRole:     &createAdminRole,
+		SkipPrincipalMember: &skipPrincipalMember,
+	}
+	if err = zmsClient.PutProviderResourceGroupRoles(ctx, dn, providerDomain, providerService, resourceGroup, d.Get("audit_ref").(string), &roles); err != nil {
+		return diag.Errorf("error adding resource group %s of domain %s for provider %s: %s", resourceGroup, dn, provider, err)
+	}
+	d.SetId(dn + TENANCY_SEPARATOR + provider + RESOURCE_GROUP_SEPARATOR + resourceGroup)
+	return readAfterWrite(resourceTenantResourceGroupRolesRead, ctx, d, meta)
+}
+
+func resourceTenantResourceGroupRolesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zmsClient := meta.(client.ZmsClient)
+	dn, provider, resourceGroup, err := splitTenantResourceGroupId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	providerDomain, providerService, err := splitServiceId(provider)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	roles, err := zmsClient.GetProviderResourceGroupRoles(ctx, dn, providerDomain, providerService, resourceGroup)
+	switch v := err.(type) {
+	case rdl.ResourceError:
+		if v.Code == 404 && !d.IsNewResource() {
+			log.Printf("[WARN] Athenz Tenant Resource Group Roles %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error retrieving Athenz Tenant Resource Group Roles %s: %s", d.Id(), v)
+	case rdl.Any:
+		return diag.FromErr(err)
+	}
+	if roles == nil || len(roles.Roles) == 0 {
+		if !d.IsNewResource() {
+			log.Printf("[WARN] Athenz Tenant Resource Group Roles %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("the roles of resource group %s were not added to the domain %s", resourceGroup, dn)
+	}
+
+	tenantRoles := make(map[string]interface{}, len(roles.Roles))
+	providerRoles := make(map[string]interface{}, len(roles.Roles))
+	for _, role := range roles.Roles {
+		tenantRole, providerRole, err := tenantResourceGroupRoleNames(dn, provider, resourceGroup, string(role.Role))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		tenantRoles[string(role.Role)] = tenantRole
+		providerRoles[string(role.Role)] = providerRole
+	}
+	if err = d.Set("domain", dn); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("provider_service", provider); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("resource_group", resourceGroup); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("role", flattenTenantRoleActions(roles.Roles)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("tenant_roles", tenantRoles); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("provider_roles", providerRoles); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceTenantResourceGroupRolesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zmsClient := meta.(client.ZmsClient)
+	dn, provider, resourceGroup, err := splitTenantResourceGroupId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	providerDomain, providerService, err := splitServiceId(provider)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = zmsClient.DeleteProviderResourceGroupRoles(ctx, dn, providerDomain, providerService, resourceGroup, d.Get("audit_ref").(string))
+
+	switch v := err.(type) {
+	case rdl.ResourceError:
+		if v.Code == 404 {
+			return nil
+		}
+		return diag.FromErr(err)
+	case rdl.Any:
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func expandTenantRoleActions(configured []interface{}) []*zms.TenantRoleAction {
+	roles := make([]*zms.TenantRoleAction, 0, len(configured))
+	for _, v := range configured {
+		m := v.(map[string]interface{})
+		roles = append(roles, &zms.TenantRoleAction{
+			Role:   zms.SimpleName(m["name"].(string)),
+			Action: m["action"].(string),
+		})
+	}
+	return roles
+}
+
+func flattenTenantRoleActions(roles []*zms.TenantRoleAction) []interface{} {
+	result := make([]interface{}, 0, len(roles))
+	for _, role := range roles {
+		result = append(result, map[string]interface{}{
+			"name":   string(role.Role),
+			"action": role.Action,
+		})
+	}
+	return result
+}
@@ -0,0 +1,47 @@
+package athenz
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AthenZ/athenz/clients/go/zms"
+	"github.com/AthenZ/terraform-provider-athenz/client"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ast "gotest.tools/assert"
+)
+
+func TestTenantResourceGroupRolesCreate(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	clientMock := client.NewMockZmsClient(mockCtrl)
+	roles := []*zms.TenantRoleAction{{Role: "writer", Action: "write"}}
+	clientMock.EXPECT().PutProviderResourceGroupRoles(gomock.Any(), "sports", "sys.storage", "api", "hockey", AUDIT_REF, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _, _, _, _, _ string, detail *zms.ProviderResourceGroupRoles) error {
+			ast.DeepEqual(t, detail.Roles, roles)
+			ast.Equal(t, detail.Tenant, zms.DomainName("sports"))
+			ast.Equal(t, *detail.CreateAdminRole, true)
+			ast.Equal(t, *detail.SkipPrincipalMember, false)
+			return nil
+		})
+	clientMock.EXPECT().GetProviderResourceGroupRoles(gomock.Any(), "sports", "sys.storage", "api", "hockey").Return(&zms.ProviderResourceGroupRoles{
+		Domain:        "sys.storage",
+		Service:       "api",
+		Tenant:        "sports",
+		ResourceGroup: "hockey",
+		Roles:         roles,
+	}, nil)
+
+	d := schema.TestResourceDataRaw(t, ResourceTenantResourceGroupRoles().Schema, map[string]interface{}{
+		"domain":           "sports",
+		"provider_service": "sys.storage.api",
+		"resource_group":   "hockey",
+		"role": []interface{}{
+			map[string]interface{}{"name": "writer", "action": "write"},
+		},
+	})
+	diags := resourceTenantResourceGroupRolesCreate(context.Background(), d, clientMock)
+	ast.Assert(t, !diags.HasError(), diags)
+	ast.Equal(t, d.Id(), "sports:tenancy.sys.storage.api/hockey")
+	ast.Equal(t, d.Get("tenant_roles.writer"), "sports:role.sys.storage.api.res_group.hockey.writer")
+	ast.Equal(t, d.Get("provider_roles.writer"), "sys.storage:role.api.tenant.sports.res_group.hockey.writer")
+}
@@ -62,7 +62,7 @@ func resourceTopLevelDomainCreate(ctx context.Context, d *schema.ResourceData, m
 		AdminUsers: convertToZmsResourceNameList(adminUsers),
 		YpmId:      &ypmId,
 	}
-	topLevelDomain, err := zmsClient.PostTopLevelDomain(auditRef, &topLevelDomainDetail)
+	topLevelDomain, err := zmsClient.PostTopLevelDomain(ctx, auditRef, &topLevelDomainDetail)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -76,7 +76,7 @@ func resourceTopLevelDomainCreate(ctx context.Context, d *schema.ResourceData, m
 func resourceTopLevelDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	zmsClient := meta.(client.ZmsClient)
 	domainName := d.Id()
-	topLevelDomain, err := zmsClient.GetDomain(domainName)
+	topLevelDomain, err := zmsClient.GetDomain(ctx, domainName)
 	switch v := err.(type) {
 	case rdl.ResourceError:
 		if v.Code == 404 {
@@ -98,7 +98,7 @@ func resourceTopLevelDomainRead(ctx context.Context, d *schema.ResourceData, met
 	if err = d.Set("name", domainName); err != nil {
 		return diag.FromErr(err)
 	}
-	adminRole, err := zmsClient.GetRole(domainName, "admin")
+	adminRole, err := zmsClient.GetRole(ctx, domainName, "admin")
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -116,7 +116,7 @@ func resourceTopLevelDomainDelete(ctx context.Context, d *schema.ResourceData, m
 	zmsClient := meta.(client.ZmsClient)
 	domainName := d.Id()
 	auditRef := d.Get("audit_ref").(string)
-	err := zmsClient.DeleteTopLevelDomain(domainName, auditRef)
+	err := zmsClient.DeleteTopLevelDomain(ctx, domainName, auditRef)
 	switch v := err.(type) {
 	case rdl.ResourceError:
 		if v.Code == 404 {
@@ -1,6 +1,7 @@
 package athenz
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
@@ -55,9 +56,9 @@ func TestAccGroupTopLevelDomainBasic(t *testing.T) {
 
 func cleanAccTestDomain(domainName string) {
 	zmsClient := testAccProvider.Meta().(client.ZmsClient)
-	_, err := zmsClient.GetDomain(domainName)
+	_, err := zmsClient.GetDomain(context.Background(), domainName)
 	if err == nil {
-		if err = zmsClient.DeleteTopLevelDomain(domainName, AUDIT_REF); err != nil {
+		if err = zmsClient.DeleteTopLevelDomain(context.Background(), domainName, AUDIT_REF); err != nil {
 			log.Fatalf("fail to delete Top Level Domain %s. error: %s", domainName, err.Error())
 		}
 	}
@@ -75,7 +76,7 @@ func testAccCheckGroupTopLevelDomainExists(resource string, d *zms.Domain) resou
 		}
 
 		zmsClient := testAccProvider.Meta().(client.ZmsClient)
-		domain, err := zmsClient.GetDomain(rs.Primary.ID)
+		domain, err := zmsClient.GetDomain(context.Background(), rs.Primary.ID)
 
 		if err != nil {
 			return err
@@ -93,7 +94,7 @@ func testAccCheckGroupTopLevelDomainDestroy(s *terraform.State) error {
 			continue
 		}
 
-		_, err := zmsClient.GetDomain(rs.Primary.ID)
+		_, err := zmsClient.GetDomain(context.Background(), rs.Primary.ID)
 
 		if err == nil {
 			return fmt.Errorf("athenz Top Level Domain still exists")
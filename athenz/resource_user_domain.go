@@ -46,7 +46,7 @@ func resourceUserDomainCreate(ctx context.Context, d *schema.ResourceData, meta
 	userDomainDetail := zms.UserDomain{
 		Name: zms.SimpleName(domainName),
 	}
-	userDomain, err := zmsClient.PostUserDomain(domainName, auditRef, &userDomainDetail)
+	userDomain, err := zmsClient.PostUserDomain(ctx, domainName, auditRef, &userDomainDetail)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -61,7 +61,7 @@ func resourceUserDomainRead(ctx context.Context, d *schema.ResourceData, meta in
 	zmsClient := meta.(client.ZmsClient)
 	domainName := d.Id()
 	shortDomainName := getShortName("", domainName, PREFIX_USER_DOMAIN)
-	userDomain, err := zmsClient.GetDomain(domainName)
+	userDomain, err := zmsClient.GetDomain(ctx, domainName)
 	switch v := err.(type) {
 	case rdl.ResourceError:
 		if v.Code == 404 {
@@ -90,7 +90,7 @@ func resourceUserDomainDelete(ctx context.Context, d *schema.ResourceData, meta
 	zmsClient := meta.(client.ZmsClient)
 	domainName := getShortName("", d.Id(), PREFIX_USER_DOMAIN)
 	auditRef := d.Get("audit_ref").(string)
-	err := zmsClient.DeleteUserDomain(domainName, auditRef)
+	err := zmsClient.DeleteUserDomain(ctx, domainName, auditRef)
 	switch v := err.(type) {
 	case rdl.ResourceError:
 		if v.Code == 404 {
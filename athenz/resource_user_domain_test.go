@@ -1,6 +1,7 @@
 package athenz
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
@@ -50,9 +51,9 @@ func TestAccGroupUserDomainBasic(t *testing.T) {
 
 func cleanAccTestUserDomain(shortId string) {
 	zmsClient := testAccProvider.Meta().(client.ZmsClient)
-	_, err := zmsClient.GetDomain(PREFIX_USER_DOMAIN + shortId)
+	_, err := zmsClient.GetDomain(context.Background(), PREFIX_USER_DOMAIN+shortId)
 	if err == nil {
-		if err = zmsClient.DeleteUserDomain(shortId, AUDIT_REF); err != nil {
+		if err = zmsClient.DeleteUserDomain(context.Background(), shortId, AUDIT_REF); err != nil {
 			log.Fatalf("fail to delete User Domain %s. error: %s", shortId, err.Error())
 		}
 	}
@@ -70,7 +71,7 @@ func testAccCheckGroupUserDomainExists(resource string, d *zms.Domain) resource.
 		}
 
 		zmsClient := testAccProvider.Meta().(client.ZmsClient)
-		domain, err := zmsClient.GetDomain(rs.Primary.ID)
+		domain, err := zmsClient.GetDomain(context.Background(), rs.Primary.ID)
 
 		if err != nil {
 			return err
@@ -88,7 +89,7 @@ func testAccCheckGroupUserDomainDestroy(s *terraform.State) error {
 			continue
 		}
 
-		_, err := zmsClient.GetDomain(rs.Primary.ID)
+		_, err := zmsClient.GetDomain(context.Background(), rs.Primary.ID)
 
 		if err == nil {
 			return fmt.Errorf("athenz User Domain still exists")
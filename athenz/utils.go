@@ -2,10 +2,17 @@ package athenz
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
 	b64 "encoding/base64"
+	"encoding/json"
+	"encoding/pem"
 	"fmt"
 	"log"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 
@@ -89,6 +96,7 @@ func dataSourceRoleSchema() map[string]*schema.Schema {
 				},
 			},
 		},
+		"pending_member": pendingRoleMemberSchema(),
 		"settings": {
 			Type:        schema.TypeSet,
 			Description: "Advanced settings",
@@ -244,6 +252,50 @@ func splitServiceId(serviceId string) (string, string, error) {
 	return splitId(serviceId, SERVICE_SEPARATOR)
 }
 
+func splitServiceHostId(serviceHostId string) (string, string, string, error) {
+	return splitServiceChildId(serviceHostId, HOST_SEPARATOR, "host")
+}
+
+func splitServicePublicKeyId(publicKeyId string) (string, string, string, error) {
+	return splitServiceChildId(publicKeyId, PUBLIC_KEY_SEPARATOR, "key_id")
+}
+
+// splitServiceChildId splits <domain_name>.<service_name><separator><child>
+// ids, the child may contain dots so it is cut off before the service id is
+// split.
+func splitServiceChildId(id, separator, childName string) (string, string, string, error) {
+	indexOfChild := strings.Index(id, separator)
+	if indexOfChild == -1 {
+		return "", "", "", fmt.Errorf("id pattern mismatch. expected: <domain_name>%s<service_name>%s<%s>", SERVICE_SEPARATOR, separator, childName)
+	}
+	dn, sn, err := splitServiceId(id[:indexOfChild])
+	if err != nil {
+		return "", "", "", err
+	}
+	return dn, sn, id[indexOfChild+len(separator):], nil
+}
+
+func splitTenancyId(tenancyId string) (string, string, error) {
+	return splitId(tenancyId, TENANCY_SEPARATOR)
+}
+
+// splitTenantResourceGroupId splits <domain_name>:tenancy.<provider_service>/<resource_group> ids.
+func splitTenantResourceGroupId(id string) (string, string, string, error) {
+	indexOfResourceGroup := strings.Index(id, RESOURCE_GROUP_SEPARATOR)
+	if indexOfResourceGroup == -1 {
+		return "", "", "", fmt.Errorf("id pattern mismatch. expected: <domain_name>%s<provider_service>%s<resource_group>", TENANCY_SEPARATOR, RESOURCE_GROUP_SEPARATOR)
+	}
+	dn, provider, err := splitTenancyId(id[:indexOfResourceGroup])
+	if err != nil {
+		return "", "", "", err
+	}
+	return dn, provider, id[indexOfResourceGroup+len(RESOURCE_GROUP_SEPARATOR):], nil
+}
+
+func splitDomainDependencyId(dependencyId string) (string, string, error) {
+	return splitId(dependencyId, DEPENDENCY_SEPARATOR)
+}
+
 func splitSubDomainId(subDomainId string) (string, string, error) {
 	return splitId(subDomainId, SUB_DOMAIN_SEPARATOR)
 }
@@ -260,6 +312,37 @@ func splitGroupId(policyId string) (string, string, error) {
 	return splitId(policyId, GROUP_SEPARATOR)
 }
 
+func splitTemplateId(templateId string) (string, string, error) {
+	return splitId(templateId, TEMPLATE_SEPARATOR)
+}
+
+func splitEntityId(entityId string) (string, string, error) {
+	return splitId(entityId, ENTITY_SEPARATOR)
+}
+
+func splitRoleMembershipId(membershipId string) (string, string, string, error) {
+	return splitMembershipId(membershipId, ROLE_SEPARATOR)
+}
+
+func splitGroupMembershipId(membershipId string) (string, string, string, error) {
+	return splitMembershipId(membershipId, GROUP_SEPARATOR)
+}
+
+// splitMembershipId splits a <domain><separator><name>/<member> id, the
+// member itself may contain the separator (e.g. a group member of a role)
+// but never a slash.
+func splitMembershipId(membershipId, separator string) (string, string, string, error) {
+	indexOfMember := strings.Index(membershipId, MEMBER_SEPARATOR)
+	if indexOfMember == -1 {
+		return "", "", "", fmt.Errorf("id pattern mismatch. expected: <domain_name>%s<name>%s<member_name>", separator, MEMBER_SEPARATOR)
+	}
+	dn, name, err := splitId(membershipId[:indexOfMember], separator)
+	if err != nil {
+		return "", "", "", err
+	}
+	return dn, name, membershipId[indexOfMember+len(MEMBER_SEPARATOR):], nil
+}
+
 func splitId(id, separator string) (string, string, error) {
 	indexOfPrefixEnd := strings.LastIndex(id, separator) // it used for all resource id (e.g. service), so we're looking for last index
 	if indexOfPrefixEnd == -1 {
@@ -270,6 +353,89 @@ func splitId(id, separator string) (string, string, error) {
 	return prefix, shortName, nil
 }
 
+// expandTemplateParams converts the keyword parameters of a template, sorted
+// by name so that the request is stable.
+func expandTemplateParams(params map[string]interface{}) []*zms.TemplateParam {
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	templateParams := make([]*zms.TemplateParam, 0, len(names))
+	for _, name := range names {
+		templateParams = append(templateParams, &zms.TemplateParam{Name: zms.SimpleName(name), Value: params[name].(string)})
+	}
+	return templateParams
+}
+
+// findTemplateMetaData returns the metadata of the named template, or nil if
+// it is not part of the list.
+func findTemplateMetaData(templates *zms.DomainTemplateDetailsList, templateName string) *zms.TemplateMetaData {
+	if templates == nil {
+		return nil
+	}
+	for _, metaData := range templates.MetaData {
+		if metaData != nil && metaData.TemplateName == templateName {
+			return metaData
+		}
+	}
+	return nil
+}
+
+func int32Value(value *int32) int {
+	if value == nil {
+		return 0
+	}
+	return int(*value)
+}
+
+// expandEntityValue converts the JSON document of an entity into its value,
+// the document must be a JSON object.
+func expandEntityValue(value string) (rdl.Struct, error) {
+	var entityValue rdl.Struct
+	if err := json.Unmarshal([]byte(value), &entityValue); err != nil {
+		return nil, fmt.Errorf("entity value must be a JSON object: %s", err)
+	}
+	if entityValue == nil {
+		return nil, fmt.Errorf("entity value must be a JSON object")
+	}
+	return entityValue, nil
+}
+
+// flattenEntityValue converts the value of an entity into a JSON document
+// with sorted keys, so that reordering the keys does not produce a diff.
+func flattenEntityValue(value rdl.Struct) (string, error) {
+	if value == nil {
+		value = rdl.Struct{}
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// normalizeEntityValue is the StateFunc of the entity value, an invalid
+// document is kept as is so that the validation reports it.
+func normalizeEntityValue(val interface{}) string {
+	entityValue, err := expandEntityValue(val.(string))
+	if err != nil {
+		return val.(string)
+	}
+	value, err := flattenEntityValue(entityValue)
+	if err != nil {
+		return val.(string)
+	}
+	return value
+}
+
+func validateEntityValue(val interface{}, _ cty.Path) diag.Diagnostics {
+	if _, err := expandEntityValue(val.(string)); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
 func expandDeprecatedRoleMembers(configured []interface{}) []*zms.RoleMember {
 	roleMembers := make([]*zms.RoleMember, 0, len(configured))
 	for _, v := range configured {
@@ -283,6 +449,102 @@ func expandDeprecatedRoleMembers(configured []interface{}) []*zms.RoleMember {
 	return roleMembers
 }
 
+// splitPendingRoleMembers separates the members waiting for an approval from
+// the approved members of a role. A pending member which is already known to
+// terraform is kept with the members, otherwise it would be requested again
+// on every run.
+func splitPendingRoleMembers(list []*zms.RoleMember, known stringSet) ([]*zms.RoleMember, []*zms.RoleMember) {
+	members := make([]*zms.RoleMember, 0, len(list))
+	pending := make([]*zms.RoleMember, 0)
+	names := stringSet{}
+	for _, m := range list {
+		if m.Approved == nil || *m.Approved {
+			members = append(members, m)
+			names.add(string(m.MemberName))
+		}
+	}
+	for _, m := range list {
+		if m.Approved != nil && !*m.Approved {
+			pending = append(pending, m)
+			if m.PendingState != PENDING_STATE_DELETE && known.contains(string(m.MemberName)) && !names.contains(string(m.MemberName)) {
+				members = append(members, m)
+			}
+		}
+	}
+	return members, pending
+}
+
+func flattenPendingRoleMembers(list []*zms.RoleMember) []interface{} {
+	pendingMembers := make([]interface{}, 0, len(list))
+	for _, m := range list {
+		pendingMembers = append(pendingMembers, map[string]interface{}{
+			"name":              string(m.MemberName),
+			"expiration":        timestampToString(m.Expiration),
+			"review":            timestampToString(m.ReviewReminder),
+			"pending_state":     m.PendingState,
+			"request_principal": string(m.RequestPrincipal),
+			"request_time":      timestampToString(m.RequestTime),
+		})
+	}
+	return pendingMembers
+}
+
+// knownMemberNames returns the names of the members of a role or group
+// resource, whether they are set with the member or the deprecated members
+// attribute.
+func knownMemberNames(d *schema.ResourceData) stringSet {
+	names := stringSet{}
+	if v, ok := d.GetOk("members"); ok {
+		for _, name := range v.(*schema.Set).List() {
+			names.add(name.(string))
+		}
+	}
+	if v, ok := d.GetOk("member"); ok {
+		for _, member := range v.(*schema.Set).List() {
+			names.add(member.(map[string]interface{})["name"].(string))
+		}
+	}
+	return names
+}
+
+func pendingRoleMemberSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Description: "Athenz principals waiting for an approval to join or leave the role",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"expiration": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"review": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"pending_state": {
+					Type:        schema.TypeString,
+					Description: "The pending request, ADD or DELETE",
+					Computed:    true,
+				},
+				"request_principal": {
+					Type:        schema.TypeString,
+					Description: "The principal who requested the change",
+					Computed:    true,
+				},
+				"request_time": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
 func flattenDeprecatedRoleMembers(list []*zms.RoleMember) []interface{} {
 	roleMembers := make([]interface{}, 0, len(list))
 	for _, m := range list {
@@ -397,20 +659,80 @@ func convertToDecodedKey(keyValue string) string {
 	return string(keyBytes)
 }
 
-func deleteRoleMember(dn string, rn string, member *zms.RoleMember, auditRef string, zmsClient client.ZmsClient) error {
+// normalizePublicKeyValue returns the PEM of a public key given either as PEM
+// or in the ybase64 form stored by ZMS, with a single trailing newline. A
+// value which is neither is kept as is so that the validation reports it.
+func normalizePublicKeyValue(val interface{}) string {
+	keyValue := strings.TrimSpace(val.(string))
+	if !strings.HasPrefix(keyValue, "-----BEGIN") {
+		decoded := strings.TrimSpace(convertToDecodedKey(keyValue))
+		if !strings.HasPrefix(decoded, "-----BEGIN") {
+			return val.(string)
+		}
+		keyValue = decoded
+	}
+	return keyValue + "\n"
+}
+
+// validatePublicKeyValue checks that the key is a RSA key of at least
+// MIN_RSA_KEY_SIZE bits or an EC key on one of the P-256, P-384 or P-521
+// curves.
+func validatePublicKeyValue(val interface{}, _ cty.Path) diag.Diagnostics {
+	if err := checkPublicKey(normalizePublicKeyValue(val)); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func checkPublicKey(keyValue string) error {
+	block, _ := pem.Decode([]byte(keyValue))
+	if block == nil {
+		return fmt.Errorf("public key must be a PEM or ybase64 encoded PEM")
+	}
+	var publicKey interface{}
+	var err error
+	switch block.Type {
+	case "PUBLIC KEY":
+		publicKey, err = x509.ParsePKIXPublicKey(block.Bytes)
+	case "RSA PUBLIC KEY":
+		publicKey, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	default:
+		return fmt.Errorf("unsupported PEM block type %s, expected PUBLIC KEY or RSA PUBLIC KEY", block.Type)
+	}
+	if err != nil {
+		return fmt.Errorf("unable to parse public key: %s", err)
+	}
+	switch key := publicKey.(type) {
+	case *rsa.PublicKey:
+		if key.N.BitLen() < MIN_RSA_KEY_SIZE {
+			return fmt.Errorf("RSA public key size is %d bits, at least %d bits are expected", key.N.BitLen(), MIN_RSA_KEY_SIZE)
+		}
+	case *ecdsa.PublicKey:
+		switch key.Curve {
+		case elliptic.P256(), elliptic.P384(), elliptic.P521():
+		default:
+			return fmt.Errorf("EC public key curve %s is not supported, expected P-256, P-384 or P-521", key.Curve.Params().Name)
+		}
+	default:
+		return fmt.Errorf("unsupported public key type %T, expected RSA or EC", publicKey)
+	}
+	return nil
+}
+
+func deleteRoleMember(ctx context.Context, dn string, rn string, member *zms.RoleMember, auditRef string, zmsClient client.ZmsClient) error {
 	name := member.MemberName
-	err := zmsClient.DeleteMembership(dn, rn, name, auditRef)
+	err := zmsClient.DeleteMembership(ctx, dn, rn, name, auditRef)
 	if err != nil {
 		return fmt.Errorf("error removing membership: %s", err)
 	}
 	return nil
 }
 
-func deleteRoleMembers(dn string, rn string, members []*zms.RoleMember, auditRef string, zmsClient client.ZmsClient, membersToNotDelete stringSet) error {
+func deleteRoleMembers(ctx context.Context, dn string, rn string, members []*zms.RoleMember, auditRef string, zmsClient client.ZmsClient, membersToNotDelete stringSet) error {
 	if members != nil {
 		for _, m := range members {
 			if !membersToNotDelete.contains(string(m.MemberName)) {
-				if err := deleteRoleMember(dn, rn, m, auditRef, zmsClient); err != nil {
+				if err := deleteRoleMember(ctx, dn, rn, m, auditRef, zmsClient); err != nil {
 					return fmt.Errorf("error removing membership: %s", err)
 				}
 			}
@@ -419,24 +741,24 @@ func deleteRoleMembers(dn string, rn string, members []*zms.RoleMember, auditRef
 	return nil
 }
 
-func addRoleMember(dn string, rn string, m *zms.RoleMember, auditRef string, zmsClient client.ZmsClient) error {
+func addRoleMember(ctx context.Context, dn string, rn string, m *zms.RoleMember, auditRef string, zmsClient client.ZmsClient) error {
 	var member zms.Membership
 	name := m.MemberName
 	member.MemberName = name
 	member.RoleName = zms.ResourceName(rn)
 	member.Expiration = m.Expiration
 	member.ReviewReminder = m.ReviewReminder
-	err := zmsClient.PutMembership(dn, rn, name, auditRef, &member)
+	err := zmsClient.PutMembership(ctx, dn, rn, name, auditRef, &member)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func addRoleMembers(dn string, rn string, members []*zms.RoleMember, auditRef string, zmsClient client.ZmsClient) error {
+func addRoleMembers(ctx context.Context, dn string, rn string, members []*zms.RoleMember, auditRef string, zmsClient client.ZmsClient) error {
 	if members != nil {
 		for _, m := range members {
-			if err := addRoleMember(dn, rn, m, auditRef, zmsClient); err != nil {
+			if err := addRoleMember(ctx, dn, rn, m, auditRef, zmsClient); err != nil {
 				return fmt.Errorf("error removing membership: %s", err)
 			}
 		}
@@ -505,6 +827,60 @@ func flattenRole(zmsRole *zms.Role, domainName string) map[string]interface{} {
 	return role
 }
 
+func flattenServices(zmsServices []*zms.ServiceIdentity, domainName string) []interface{} {
+	services := make([]interface{}, 0, len(zmsServices))
+	for _, service := range zmsServices {
+		services = append(services, flattenService(service, domainName))
+	}
+	return services
+}
+
+func flattenService(zmsService *zms.ServiceIdentity, domainName string) map[string]interface{} {
+	service := make(map[string]interface{})
+	service["domain"] = domainName
+	service["name"] = string(zmsService.Name)
+	service["description"] = zmsService.Description
+	if len(zmsService.PublicKeys) > 0 {
+		service["public_keys"] = flattenPublicKeyEntryList(zmsService.PublicKeys)
+	}
+	if len(zmsService.Hosts) > 0 {
+		service["hosts"] = zmsService.Hosts
+	}
+	if len(zmsService.Tags) > 0 {
+		service["tags"] = flattenTag(zmsService.Tags)
+	}
+	service["executable"] = zmsService.Executable
+	service["user"] = zmsService.User
+	service["group"] = zmsService.Group
+	for _, field := range serviceSystemMetaFields {
+		service[field.name] = field.service(zmsService)
+	}
+	return service
+}
+
+func flattenPolicies(zmsPolicies []*zms.Policy, domainName string) []interface{} {
+	policies := make([]interface{}, 0, len(zmsPolicies))
+	for _, policy := range zmsPolicies {
+		policies = append(policies, flattenPolicy(policy, domainName))
+	}
+	return policies
+}
+
+func flattenPolicy(zmsPolicy *zms.Policy, domainName string) map[string]interface{} {
+	policy := make(map[string]interface{})
+	policy["domain"] = domainName
+	policy["name"] = string(zmsPolicy.Name)
+	if len(zmsPolicy.Assertions) > 0 {
+		policy["assertion"] = flattenPolicyAssertion(zmsPolicy.Assertions)
+	}
+	if len(zmsPolicy.Tags) > 0 {
+		policy["tags"] = flattenTag(zmsPolicy.Tags)
+	}
+	policy["version"] = string(zmsPolicy.Version)
+	policy["active"] = zmsPolicy.Active == nil || *zmsPolicy.Active
+	return policy
+}
+
 // input - the schema and the key that you want to get the changes from
 // output - os- the old set , ns the new set
 func handleChange(d *schema.ResourceData, key string) (*schema.Set, *schema.Set) {
@@ -638,7 +1014,11 @@ func readAfterWrite(readFunc func(context.Context, *schema.ResourceData, interfa
 
 	// 2 more retries after 5 and 10 seconds
 	for i := 1; diags.HasError() && i < 3; i++ {
-		time.Sleep(time.Duration(i) * time.Duration(5) * time.Second)
+		select {
+		case <-ctx.Done():
+			return diag.FromErr(ctx.Err())
+		case <-time.After(time.Duration(i) * time.Duration(5) * time.Second):
+		}
 		log.Print("[WARN] resource did not found, about to try again")
 		diags = readFunc(ctx, d, meta)
 	}
@@ -1,10 +1,12 @@
 package athenz
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/AthenZ/athenz/clients/go/zms"
 	"github.com/AthenZ/terraform-provider-athenz/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func expandDeprecatedGroupMembers(configured []interface{}) []*zms.GroupMember {
@@ -42,6 +44,197 @@ func expandGroupMembers(configured []interface{}) []*zms.GroupMember {
 	return groupMembers
 }
 
+func dataSourceGroupSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"domain": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"member": {
+			Type:        schema.TypeSet,
+			Description: "Users or services to be added as members",
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"expiration": {
+						Type:     schema.TypeString,
+						Optional: true,
+						Default:  "",
+					},
+				},
+			},
+		},
+		"tags": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"pending_member": pendingGroupMemberSchema(),
+		"settings": {
+			Type:        schema.TypeSet,
+			Description: "Advanced settings",
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"user_expiry_days": {
+						Type:     schema.TypeInt,
+						Optional: true,
+					},
+					"service_expiry_days": {
+						Type:     schema.TypeInt,
+						Optional: true,
+					},
+					"max_members": {
+						Type:     schema.TypeInt,
+						Optional: true,
+					},
+				},
+			},
+		},
+		"self_serve": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"audit_enabled": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"self_renew": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"self_renew_mins": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		"delete_protection": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"review_enabled": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"user_authority_filter": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "",
+		},
+		"user_authority_expiration": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "",
+		},
+		"notify_roles": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "",
+		},
+		"notify_details": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "",
+		},
+		"last_reviewed_date": {
+			Type:        schema.TypeString,
+			Description: "Last reviewed date for the group",
+			Optional:    true,
+		},
+		"principal_domain_filter": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "",
+		},
+	}
+}
+
+// splitPendingGroupMembers separates the members waiting for an approval
+// from the approved members of a group, see splitPendingRoleMembers.
+func splitPendingGroupMembers(list []*zms.GroupMember, known stringSet) ([]*zms.GroupMember, []*zms.GroupMember) {
+	members := make([]*zms.GroupMember, 0, len(list))
+	pending := make([]*zms.GroupMember, 0)
+	names := stringSet{}
+	for _, m := range list {
+		if m.Approved == nil || *m.Approved {
+			members = append(members, m)
+			names.add(string(m.MemberName))
+		}
+	}
+	for _, m := range list {
+		if m.Approved != nil && !*m.Approved {
+			pending = append(pending, m)
+			if m.PendingState != PENDING_STATE_DELETE && known.contains(string(m.MemberName)) && !names.contains(string(m.MemberName)) {
+				members = append(members, m)
+			}
+		}
+	}
+	return members, pending
+}
+
+func flattenPendingGroupMembers(list []*zms.GroupMember) []interface{} {
+	pendingMembers := make([]interface{}, 0, len(list))
+	for _, m := range list {
+		pendingMembers = append(pendingMembers, map[string]interface{}{
+			"name":              string(m.MemberName),
+			"expiration":        timestampToString(m.Expiration),
+			"pending_state":     m.PendingState,
+			"request_principal": string(m.RequestPrincipal),
+			"request_time":      timestampToString(m.RequestTime),
+		})
+	}
+	return pendingMembers
+}
+
+func pendingGroupMemberSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Description: "Athenz principals waiting for an approval to join or leave the group",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"expiration": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"pending_state": {
+					Type:        schema.TypeString,
+					Description: "The pending request, ADD or DELETE",
+					Computed:    true,
+				},
+				"request_principal": {
+					Type:        schema.TypeString,
+					Description: "The principal who requested the change",
+					Computed:    true,
+				},
+				"request_time": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
 func flattenGroupMembers(list []*zms.GroupMember) []interface{} {
 	groupMembers := make([]interface{}, 0, len(list))
 	for _, m := range list {
@@ -56,7 +249,81 @@ func flattenGroupMembers(list []*zms.GroupMember) []interface{} {
 	return groupMembers
 }
 
-func updateGroupMembers(dn string, gn string, remove []*zms.GroupMember, add []*zms.GroupMember, zmsClient client.ZmsClient, auditRef string) error {
+func flattenGroups(zmsGroups []*zms.Group, domainName string) []interface{} {
+	groups := make([]interface{}, 0, len(zmsGroups))
+	for _, group := range zmsGroups {
+		groups = append(groups, flattenGroup(group, domainName))
+	}
+	return groups
+}
+
+func flattenGroup(zmsGroup *zms.Group, domainName string) map[string]interface{} {
+	group := make(map[string]interface{})
+	group["domain"] = domainName
+	group["name"] = string(zmsGroup.Name)
+	groupMembers, pendingMembers := splitPendingGroupMembers(zmsGroup.GroupMembers, stringSet{})
+	if len(groupMembers) > 0 {
+		group["member"] = flattenGroupMembers(groupMembers)
+	}
+	if len(pendingMembers) > 0 {
+		group["pending_member"] = flattenPendingGroupMembers(pendingMembers)
+	}
+	if len(zmsGroup.Tags) > 0 {
+		group["tags"] = flattenTag(zmsGroup.Tags)
+	}
+	groupSettings := map[string]int{}
+	if zmsGroup.MemberExpiryDays != nil {
+		groupSettings["user_expiry_days"] = int(*zmsGroup.MemberExpiryDays)
+	}
+	if zmsGroup.ServiceExpiryDays != nil {
+		groupSettings["service_expiry_days"] = int(*zmsGroup.ServiceExpiryDays)
+	}
+	if zmsGroup.MaxMembers != nil {
+		groupSettings["max_members"] = int(*zmsGroup.MaxMembers)
+	}
+	if len(groupSettings) > 0 {
+		group["settings"] = flattenIntSettings(groupSettings)
+	}
+	if zmsGroup.SelfServe != nil {
+		group["self_serve"] = *zmsGroup.SelfServe
+	}
+	if zmsGroup.AuditEnabled != nil {
+		group["audit_enabled"] = *zmsGroup.AuditEnabled
+	}
+	if zmsGroup.SelfRenew != nil {
+		group["self_renew"] = *zmsGroup.SelfRenew
+	}
+	if zmsGroup.SelfRenewMins != nil {
+		group["self_renew_mins"] = int(*zmsGroup.SelfRenewMins)
+	}
+	if zmsGroup.DeleteProtection != nil {
+		group["delete_protection"] = *zmsGroup.DeleteProtection
+	}
+	if zmsGroup.ReviewEnabled != nil {
+		group["review_enabled"] = *zmsGroup.ReviewEnabled
+	}
+	if zmsGroup.UserAuthorityFilter != "" {
+		group["user_authority_filter"] = zmsGroup.UserAuthorityFilter
+	}
+	if zmsGroup.UserAuthorityExpiration != "" {
+		group["user_authority_expiration"] = zmsGroup.UserAuthorityExpiration
+	}
+	if zmsGroup.NotifyRoles != "" {
+		group["notify_roles"] = zmsGroup.NotifyRoles
+	}
+	if zmsGroup.NotifyDetails != "" {
+		group["notify_details"] = zmsGroup.NotifyDetails
+	}
+	if zmsGroup.LastReviewedDate != nil {
+		group["last_reviewed_date"] = timestampToString(zmsGroup.LastReviewedDate)
+	}
+	if zmsGroup.PrincipalDomainFilter != "" {
+		group["principal_domain_filter"] = zmsGroup.PrincipalDomainFilter
+	}
+	return group
+}
+
+func updateGroupMembers(ctx context.Context, dn string, gn string, remove []*zms.GroupMember, add []*zms.GroupMember, zmsClient client.ZmsClient, auditRef string) error {
 
 	// we don't want to delete a member that should be added right after
 	membersToNotDelete := stringSet{}
@@ -68,7 +335,7 @@ func updateGroupMembers(dn string, gn string, remove []*zms.GroupMember, add []*
 		for _, member := range remove {
 			if !membersToNotDelete.contains(string(member.MemberName)) {
 				name := member.MemberName
-				err := zmsClient.DeleteGroupMembership(dn, gn, name, auditRef)
+				err := zmsClient.DeleteGroupMembership(ctx, dn, gn, name, auditRef)
 				if err != nil {
 					return fmt.Errorf("Error removing membership: %s", err)
 				}
@@ -83,7 +350,7 @@ func updateGroupMembers(dn string, gn string, remove []*zms.GroupMember, add []*
 			member.MemberName = name
 			member.Expiration = m.Expiration
 			member.GroupName = zms.ResourceName(gn)
-			err := zmsClient.PutGroupMembership(dn, gn, name, auditRef, &member)
+			err := zmsClient.PutGroupMembership(ctx, dn, gn, name, auditRef, &member)
 			if err != nil {
 				return err
 			}
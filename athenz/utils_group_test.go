@@ -20,8 +20,8 @@ func TestUpdateGroupMembers(t *testing.T) {
 	}
 	mockCtrl := gomock.NewController(t)
 	clientMock := client.NewMockZmsClient(mockCtrl)
-	clientMock.EXPECT().GetRole(gomock.Any(), gomock.Any()).Return(&zms.Role{Name: "test"}, nil).AnyTimes()
-	clientMock.EXPECT().PutRole(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	clientMock.EXPECT().GetRole(gomock.Any(), gomock.Any(), gomock.Any()).Return(&zms.Role{Name: "test"}, nil).AnyTimes()
+	clientMock.EXPECT().PutRole(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	// _ = args{
 	// 	zmsClient: clientMock,
@@ -65,3 +65,26 @@ func TestExpandGroupMembers(t *testing.T) {
 func TestFlattenGroupMember(t *testing.T) {
 	ast.DeepEqual(t, flattenGroupMembers(getZmsGroupMembers()), getFlattedGroupMembers())
 }
+
+func TestSplitPendingGroupMembers(t *testing.T) {
+	approved, pending := true, false
+	list := []*zms.GroupMember{
+		{MemberName: "user.joe", Approved: &approved},
+		{MemberName: "user.bob", Approved: &pending, PendingState: "ADD"},
+		{MemberName: "user.alice", Approved: &pending, PendingState: "ADD"},
+	}
+	members, pendingMembers := splitPendingGroupMembers(list, stringSet{"user.bob": {}})
+	ast.DeepEqual(t, members, []*zms.GroupMember{list[0], list[1]})
+	ast.DeepEqual(t, pendingMembers, []*zms.GroupMember{list[1], list[2]})
+
+	expected := []interface{}{
+		map[string]interface{}{
+			"name":              "user.alice",
+			"expiration":        "",
+			"pending_state":     "ADD",
+			"request_principal": "",
+			"request_time":      "",
+		},
+	}
+	ast.DeepEqual(t, flattenPendingGroupMembers(pendingMembers[1:]), expected)
+}
@@ -1,11 +1,19 @@
 package athenz
 
 import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/ardielle/ardielle-go/rdl"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -487,3 +495,224 @@ func TestTimestampToString(t *testing.T) {
 	assert.Equal(t, "2023-01-02 03:10:12", timestampToString(&tsWithNano))
 	assert.Equal(t, "2023-01-02 03:10:12", timestampToString(&tsWithoutNano))
 }
+
+func TestSplitTemplateId(t *testing.T) {
+	templateId := "some_domain" + TEMPLATE_SEPARATOR + "aws"
+	dn, tn, err := splitTemplateId(templateId)
+	ast.NilError(t, err)
+	ast.Equal(t, "some_domain", dn)
+	ast.Equal(t, "aws", tn)
+}
+
+func TestSplitEntityId(t *testing.T) {
+	entityId := "some_domain" + ENTITY_SEPARATOR + "config.db"
+	dn, en, err := splitEntityId(entityId)
+	ast.NilError(t, err)
+	ast.Equal(t, "some_domain", dn)
+	ast.Equal(t, "config.db", en)
+}
+
+func getTestPendingRoleMembers() []*zms.RoleMember {
+	approved, pending := true, false
+	return []*zms.RoleMember{
+		{MemberName: "user.joe", Approved: &approved},
+		{MemberName: "user.jane"},
+		{MemberName: "user.bob", Approved: &pending, PendingState: "ADD", RequestPrincipal: "user.bob"},
+		{MemberName: "user.alice", Approved: &pending, PendingState: "ADD"},
+		{MemberName: "user.joe", Approved: &pending, PendingState: PENDING_STATE_DELETE},
+	}
+}
+
+func TestSplitPendingRoleMembers(t *testing.T) {
+	list := getTestPendingRoleMembers()
+	members, pending := splitPendingRoleMembers(list, stringSet{"user.bob": {}, "user.joe": {}})
+	ast.DeepEqual(t, members, []*zms.RoleMember{list[0], list[1], list[2]})
+	ast.DeepEqual(t, pending, []*zms.RoleMember{list[2], list[3], list[4]})
+
+	members, pending = splitPendingRoleMembers(list, stringSet{})
+	ast.DeepEqual(t, members, []*zms.RoleMember{list[0], list[1]})
+	ast.Equal(t, len(pending), 3)
+}
+
+func TestFlattenPendingRoleMembers(t *testing.T) {
+	expected := []interface{}{
+		map[string]interface{}{
+			"name":              "user.bob",
+			"expiration":        "",
+			"review":            "",
+			"pending_state":     "ADD",
+			"request_principal": "user.bob",
+			"request_time":      "",
+		},
+	}
+	ast.DeepEqual(t, flattenPendingRoleMembers(getTestPendingRoleMembers()[2:3]), expected)
+}
+
+func TestKnownMemberNames(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceRole().Schema, map[string]interface{}{
+		"domain": "some_domain",
+		"name":   "readers",
+		"member": []interface{}{
+			map[string]interface{}{"name": "user.joe"},
+			map[string]interface{}{"name": "user.jane", "expiration": "2099-12-31 23:59:59"},
+		},
+	})
+	ast.DeepEqual(t, knownMemberNames(d), stringSet{"user.joe": {}, "user.jane": {}})
+
+	d = schema.TestResourceDataRaw(t, ResourceRole().Schema, map[string]interface{}{
+		"domain":  "some_domain",
+		"name":    "readers",
+		"members": []interface{}{"user.bob"},
+	})
+	ast.DeepEqual(t, knownMemberNames(d), stringSet{"user.bob": {}})
+}
+
+func TestSplitMembershipId(t *testing.T) {
+	dn, rn, member, err := splitRoleMembershipId("some_domain" + ROLE_SEPARATOR + "readers" + MEMBER_SEPARATOR + "user.joe")
+	ast.NilError(t, err)
+	ast.Equal(t, "some_domain", dn)
+	ast.Equal(t, "readers", rn)
+	ast.Equal(t, "user.joe", member)
+
+	// a group can be a member of a role
+	dn, rn, member, err = splitRoleMembershipId("some_domain" + ROLE_SEPARATOR + "readers" + MEMBER_SEPARATOR + "other_domain" + GROUP_SEPARATOR + "devs")
+	ast.NilError(t, err)
+	ast.Equal(t, "some_domain", dn)
+	ast.Equal(t, "readers", rn)
+	ast.Equal(t, "other_domain:group.devs", member)
+
+	dn, gn, member, err := splitGroupMembershipId("some_domain" + GROUP_SEPARATOR + "devs" + MEMBER_SEPARATOR + "user.jane")
+	ast.NilError(t, err)
+	ast.Equal(t, "some_domain", dn)
+	ast.Equal(t, "devs", gn)
+	ast.Equal(t, "user.jane", member)
+
+	_, _, _, err = splitRoleMembershipId("some_domain" + ROLE_SEPARATOR + "readers")
+	ast.ErrorContains(t, err, "id pattern mismatch")
+	_, _, _, err = splitGroupMembershipId("some_domain" + ROLE_SEPARATOR + "readers" + MEMBER_SEPARATOR + "user.joe")
+	ast.ErrorContains(t, err, "id pattern mismatch")
+}
+
+func TestEntityValue(t *testing.T) {
+	value, err := expandEntityValue(`{"b": [1, "two"], "a": {"d": true, "c": null}}`)
+	ast.NilError(t, err)
+	flattened, err := flattenEntityValue(value)
+	ast.NilError(t, err)
+	ast.Equal(t, flattened, `{"a":{"c":null,"d":true},"b":[1,"two"]}`)
+	ast.Equal(t, normalizeEntityValue(`{ "b": [1, "two"],
+		"a": {"c": null, "d": true} }`), flattened)
+
+	flattened, err = flattenEntityValue(nil)
+	ast.NilError(t, err)
+	ast.Equal(t, flattened, "{}")
+
+	for _, invalid := range []string{"", "null", "[1]", `"text"`, "{"} {
+		_, err = expandEntityValue(invalid)
+		ast.ErrorContains(t, err, "entity value must be a JSON object")
+		ast.Equal(t, normalizeEntityValue(invalid), invalid)
+		ast.Assert(t, validateEntityValue(invalid, nil).HasError())
+	}
+}
+
+func TestExpandTemplateParams(t *testing.T) {
+	params := map[string]interface{}{"service": "api", "account": "123456789012"}
+	expected := []*zms.TemplateParam{
+		{Name: "account", Value: "123456789012"},
+		{Name: "service", Value: "api"},
+	}
+	ast.DeepEqual(t, expandTemplateParams(params), expected)
+	ast.DeepEqual(t, expandTemplateParams(map[string]interface{}{}), []*zms.TemplateParam{})
+}
+
+func TestFindTemplateMetaData(t *testing.T) {
+	currentVersion := int32(3)
+	templates := &zms.DomainTemplateDetailsList{
+		MetaData: []*zms.TemplateMetaData{
+			{TemplateName: "aws", CurrentVersion: &currentVersion},
+			{TemplateName: "zts_instance_provider"},
+		},
+	}
+	metaData := findTemplateMetaData(templates, "aws")
+	ast.Assert(t, metaData != nil)
+	ast.Equal(t, int32Value(metaData.CurrentVersion), 3)
+	ast.Assert(t, findTemplateMetaData(templates, "k8s") == nil)
+	ast.Assert(t, findTemplateMetaData(nil, "aws") == nil)
+	ast.Equal(t, int32Value(nil), 0)
+}
+
+func TestSplitServiceHostId(t *testing.T) {
+	dn, sn, host, err := splitServiceHostId("some_domain.sub.api" + HOST_SEPARATOR + "host1.example.com")
+	ast.NilError(t, err)
+	ast.Equal(t, "some_domain.sub", dn)
+	ast.Equal(t, "api", sn)
+	ast.Equal(t, "host1.example.com", host)
+
+	_, _, _, err = splitServiceHostId("some_domain.api")
+	ast.Assert(t, err != nil)
+}
+
+func getTestPublicKeyPem(t *testing.T, key interface{}) string {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	ast.NilError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+func TestNormalizePublicKeyValue(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	ast.NilError(t, err)
+	keyPem := getTestPublicKeyPem(t, &rsaKey.PublicKey)
+
+	ast.Equal(t, normalizePublicKeyValue(keyPem), keyPem)
+	ast.Equal(t, normalizePublicKeyValue("\n"+keyPem+"\n\n"), keyPem)
+	ast.Equal(t, normalizePublicKeyValue(convertToKeyBase64(keyPem)), keyPem)
+	ast.Equal(t, normalizePublicKeyValue("not a key"), "not a key")
+}
+
+func TestCheckPublicKey(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	ast.NilError(t, err)
+	ast.NilError(t, checkPublicKey(getTestPublicKeyPem(t, &rsaKey.PublicKey)))
+	pkcs1Pem := string(pem.EncodeToMemory(&pem.Block{Type: "RSA PUBLIC KEY", Bytes: x509.MarshalPKCS1PublicKey(&rsaKey.PublicKey)}))
+	ast.NilError(t, checkPublicKey(pkcs1Pem))
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	ast.NilError(t, err)
+	ast.NilError(t, checkPublicKey(getTestPublicKeyPem(t, &ecKey.PublicKey)))
+
+	smallKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	ast.NilError(t, err)
+	ast.Error(t, checkPublicKey(getTestPublicKeyPem(t, &smallKey.PublicKey)), "RSA public key size is 1024 bits, at least 2048 bits are expected")
+
+	ecKey, err = ecdsa.GenerateKey(elliptic.P224(), rand.Reader)
+	ast.NilError(t, err)
+	ast.Error(t, checkPublicKey(getTestPublicKeyPem(t, &ecKey.PublicKey)), "EC public key curve P-224 is not supported, expected P-256, P-384 or P-521")
+
+	ast.Error(t, checkPublicKey("not a key"), "public key must be a PEM or ybase64 encoded PEM")
+	ast.Error(t, checkPublicKey("-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n"), "unsupported PEM block type CERTIFICATE, expected PUBLIC KEY or RSA PUBLIC KEY")
+}
+
+func TestSplitServicePublicKeyId(t *testing.T) {
+	dn, sn, keyId, err := splitServicePublicKeyId("some_domain.api" + PUBLIC_KEY_SEPARATOR + "v1")
+	ast.NilError(t, err)
+	ast.Equal(t, "some_domain", dn)
+	ast.Equal(t, "api", sn)
+	ast.Equal(t, "v1", keyId)
+}
+
+func TestReadAfterWriteCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	reads := 0
+	readFunc := func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		reads++
+		cancel()
+		return diag.Errorf("500 internal server error")
+	}
+	d := ResourceDomainQuota().Data(nil)
+	d.SetId("some_domain")
+
+	start := time.Now()
+	diags := readAfterWrite(readFunc, ctx, d, nil)
+	ast.Assert(t, time.Since(start) < time.Second)
+	ast.Equal(t, reads, 1)
+	ast.Equal(t, diags[0].Summary, context.Canceled.Error())
+}
@@ -20,6 +20,7 @@ const (
 	GROUP_MEMBER_NAME         = "GroupMemberName"
 	ASSERTION_CONDITION_VALUE = "AssertionConditionValue"
 	RESOURCE_NAME             = "ResourceName"
+	SERVICE_NAME              = "ServiceName"
 )
 
 var rdlSchema *rdl.Schema
@@ -36,6 +37,7 @@ func init() {
 		GROUP_MEMBER_NAME:         buildRegexFromRdlSchema(GROUP_MEMBER_NAME),
 		ASSERTION_CONDITION_VALUE: buildRegexFromRdlSchema(ASSERTION_CONDITION_VALUE),
 		RESOURCE_NAME:             buildRegexFromRdlSchema(RESOURCE_NAME),
+		SERVICE_NAME:              buildRegexFromRdlSchema(SERVICE_NAME),
 	}
 }
 
@@ -0,0 +1,136 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	DefaultCertReloadInterval = time.Minute
+
+	// certExpiryWindow forces a reload attempt, regardless of the file
+	// modification times, once the loaded certificate is about to expire.
+	certExpiryWindow = 10 * time.Minute
+)
+
+// certReloader keeps the client certificate loaded from certFile/keyFile up
+// to date. Certificates issued by SIA are short-lived and rotated on disk,
+// so a multi-hour apply must pick up the new pair instead of failing with
+// TLS errors once the original one expires.
+type certReloader struct {
+	certFile string
+	keyFile  string
+	interval time.Duration
+
+	mu          sync.RWMutex
+	cert        *tls.Certificate
+	notAfter    time.Time
+	certModTime time.Time
+	keyModTime  time.Time
+	lastCheck   time.Time
+}
+
+func newCertReloader(certFile, keyFile string, interval time.Duration) (*certReloader, error) {
+	r := &certReloader{
+		certFile: certFile,
+		keyFile:  keyFile,
+		interval: interval,
+	}
+	cert, notAfter, err := loadKeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+	r.cert = cert
+	r.notAfter = notAfter
+	r.certModTime, r.keyModTime = r.modTimes()
+	r.lastCheck = time.Now()
+	return r, nil
+}
+
+// GetClientCertificate implements tls.Config.GetClientCertificate and is
+// called for every new TLS connection.
+func (r *certReloader) GetClientCertificate(info *tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	ctx := context.Background()
+	if info != nil {
+		// the context of the handshake, i.e. of the request being sent
+		ctx = info.Context()
+	}
+	r.maybeReload(ctx, time.Now())
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cert, nil
+}
+
+// maybeReload re-reads the key pair when the files changed on disk or the
+// current certificate is close to expiry. Checks are rate limited by the
+// reload interval. It returns true if a new certificate was loaded, in
+// which case existing connections still present the previous one.
+func (r *certReloader) maybeReload(ctx context.Context, now time.Time) bool {
+	if r == nil || r.interval <= 0 {
+		return false
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if now.Sub(r.lastCheck) < r.interval {
+		return false
+	}
+	r.lastCheck = now
+
+	certModTime, keyModTime := r.modTimes()
+	changed := !certModTime.Equal(r.certModTime) || !keyModTime.Equal(r.keyModTime)
+	if !changed && r.notAfter.Sub(now) > certExpiryWindow {
+		return false
+	}
+	cert, notAfter, err := loadKeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		// the cert and key files are not replaced atomically, so keep the
+		// current pair and try again on the next check
+		tflog.Warn(ctx, "unable to reload client certificate", map[string]interface{}{
+			"cert_file": r.certFile,
+			"error":     err.Error(),
+		})
+		return false
+	}
+	r.certModTime, r.keyModTime = certModTime, keyModTime
+	if !changed && notAfter.Equal(r.notAfter) {
+		return false
+	}
+	r.cert = cert
+	r.notAfter = notAfter
+	tflog.Info(ctx, "reloaded client certificate", map[string]interface{}{
+		"cert_file":   r.certFile,
+		"valid_until": notAfter.Format(time.RFC3339),
+	})
+	return true
+}
+
+func (r *certReloader) modTimes() (time.Time, time.Time) {
+	var certModTime, keyModTime time.Time
+	if info, err := os.Stat(r.certFile); err == nil {
+		certModTime = info.ModTime()
+	}
+	if info, err := os.Stat(r.keyFile); err == nil {
+		keyModTime = info.ModTime()
+	}
+	return certModTime, keyModTime
+}
+
+func loadKeyPair(certFile, keyFile string) (*tls.Certificate, time.Time, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("unable to formulate clientCert from key and cert bytes, error: %v", err)
+	}
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("unable to parse client certificate, error: %v", err)
+	}
+	cert.Leaf = leaf
+	return &cert, leaf.NotAfter, nil
+}
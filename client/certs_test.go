@@ -0,0 +1,260 @@
+package client
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// generateTestKeyPair returns a PEM encoded self-signed certificate and key
+// for the given common name.
+func generateTestKeyPair(t *testing.T, commonName string, notAfter time.Time) ([]byte, []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPem := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+	return certPem, keyPem
+}
+
+func writeTestKeyPair(t *testing.T, dir, commonName string, notAfter time.Time, modTime time.Time) (string, string) {
+	t.Helper()
+	certPem, keyPem := generateTestKeyPair(t, commonName, notAfter)
+	certFile := filepath.Join(dir, "cert.pem")
+	keyFile := filepath.Join(dir, "key.pem")
+	for file, data := range map[string][]byte{certFile: certPem, keyFile: keyPem} {
+		if err := os.WriteFile(file, data, 0600); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(file, modTime, modTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return certFile, keyFile
+}
+
+// newTestTLSServer starts a TLS server that requires a client certificate and
+// answers every request with a domain named after the client certificate
+// common name. The server CA is written to a file for the client.
+func newTestTLSServer(t *testing.T) (*httptest.Server, string) {
+	t.Helper()
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"name":"` + r.TLS.PeerCertificates[0].Subject.CommonName + `"}`))
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	caPem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := os.WriteFile(caFile, caPem, 0600); err != nil {
+		t.Fatal(err)
+	}
+	return server, caFile
+}
+
+func TestClientReloadsRotatedCertificate(t *testing.T) {
+	server, caFile := newTestTLSServer(t)
+	dir := t.TempDir()
+	start := time.Now().Add(-time.Hour)
+	certFile, keyFile := writeTestKeyPair(t, dir, "client-1", time.Now().Add(24*time.Hour), start)
+
+	c, err := NewClient(&ZmsConfig{
+		Url:                server.URL,
+		Cert:               certFile,
+		Key:                keyFile,
+		CaCert:             caFile,
+		CertReloadInterval: time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	domain, err := c.GetDomain(context.Background(), "sys.auth")
+	if err != nil {
+		t.Fatalf("GetDomain() error = %v", err)
+	}
+	if domain.Name != "client-1" {
+		t.Fatalf("server saw certificate %s, want client-1", domain.Name)
+	}
+
+	writeTestKeyPair(t, dir, "client-2", time.Now().Add(24*time.Hour), start.Add(time.Minute))
+	time.Sleep(5 * time.Millisecond)
+	domain, err = c.GetDomain(context.Background(), "sys.auth")
+	if err != nil {
+		t.Fatalf("GetDomain() after rotation error = %v", err)
+	}
+	if domain.Name != "client-2" {
+		t.Fatalf("server saw certificate %s after rotation, want client-2", domain.Name)
+	}
+}
+
+func TestClientKeepsCertificateWhenReloadDisabled(t *testing.T) {
+	server, caFile := newTestTLSServer(t)
+	dir := t.TempDir()
+	start := time.Now().Add(-time.Hour)
+	certFile, keyFile := writeTestKeyPair(t, dir, "client-1", time.Now().Add(24*time.Hour), start)
+
+	c, err := NewClient(&ZmsConfig{Url: server.URL, Cert: certFile, Key: keyFile, CaCert: caFile})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	writeTestKeyPair(t, dir, "client-2", time.Now().Add(24*time.Hour), start.Add(time.Minute))
+	c.Transport.CloseIdleConnections()
+	domain, err := c.GetDomain(context.Background(), "sys.auth")
+	if err != nil {
+		t.Fatalf("GetDomain() error = %v", err)
+	}
+	if domain.Name != "client-1" {
+		t.Fatalf("server saw certificate %s, want client-1", domain.Name)
+	}
+}
+
+func TestCertReloaderKeepsPairOnPartialRotation(t *testing.T) {
+	dir := t.TempDir()
+	start := time.Now().Add(-time.Hour)
+	certFile, keyFile := writeTestKeyPair(t, dir, "client-1", time.Now().Add(24*time.Hour), start)
+	reloader, err := newCertReloader(certFile, keyFile, time.Millisecond)
+	if err != nil {
+		t.Fatalf("newCertReloader() error = %v", err)
+	}
+
+	// only the certificate has been replaced so far
+	certPem, _ := generateTestKeyPair(t, "client-2", time.Now().Add(24*time.Hour))
+	if err := os.WriteFile(certFile, certPem, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if reloader.maybeReload(context.Background(), time.Now().Add(time.Second)) {
+		t.Fatal("maybeReload() loaded a mismatched key pair")
+	}
+	cert, err := reloader.GetClientCertificate(nil)
+	if err != nil {
+		t.Fatalf("GetClientCertificate() error = %v", err)
+	}
+	if cert.Leaf.Subject.CommonName != "client-1" {
+		t.Fatalf("GetClientCertificate() = %s, want client-1", cert.Leaf.Subject.CommonName)
+	}
+}
+
+func TestCertReloaderReloadsNearExpiry(t *testing.T) {
+	dir := t.TempDir()
+	modTime := time.Now().Add(-time.Hour)
+	certFile, keyFile := writeTestKeyPair(t, dir, "client-1", time.Now().Add(time.Minute), modTime)
+	reloader, err := newCertReloader(certFile, keyFile, time.Millisecond)
+	if err != nil {
+		t.Fatalf("newCertReloader() error = %v", err)
+	}
+	// replaced in place with the same modification time
+	writeTestKeyPair(t, dir, "client-2", time.Now().Add(24*time.Hour), modTime)
+	if !reloader.maybeReload(context.Background(), time.Now().Add(time.Second)) {
+		t.Fatal("maybeReload() did not reload a certificate about to expire")
+	}
+	cert, _ := reloader.GetClientCertificate(nil)
+	if cert.Leaf.Subject.CommonName != "client-2" {
+		t.Fatalf("GetClientCertificate() = %s, want client-2", cert.Leaf.Subject.CommonName)
+	}
+}
+
+func TestGetTLSConfigFromBytes(t *testing.T) {
+	certPem, keyPem := generateTestKeyPair(t, "client-1", time.Now().Add(time.Hour))
+	caPem, _ := generateTestKeyPair(t, "ca", time.Now().Add(time.Hour))
+
+	config, err := getTLSConfigFromBytes(certPem, keyPem, caPem)
+	if err != nil {
+		t.Fatalf("getTLSConfigFromBytes() error = %v", err)
+	}
+	if len(config.Certificates) != 1 {
+		t.Errorf("getTLSConfigFromBytes() returned %d certificates, want 1", len(config.Certificates))
+	}
+	if config.RootCAs == nil {
+		t.Errorf("getTLSConfigFromBytes() did not set RootCAs")
+	}
+
+	_, otherKeyPem := generateTestKeyPair(t, "client-2", time.Now().Add(time.Hour))
+	if _, err := getTLSConfigFromBytes(certPem, otherKeyPem, nil); err == nil {
+		t.Errorf("getTLSConfigFromBytes() accepted a mismatched key")
+	}
+	if _, err := getTLSConfigFromBytes(certPem, keyPem, []byte("not a pem")); err == nil {
+		t.Errorf("getTLSConfigFromBytes() accepted an invalid cacert")
+	}
+}
+
+func TestClientWithInlinePem(t *testing.T) {
+	server, caFile := newTestTLSServer(t)
+	caPem, err := os.ReadFile(caFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPem, keyPem := generateTestKeyPair(t, "inline", time.Now().Add(time.Hour))
+
+	c, err := NewClient(&ZmsConfig{
+		Url:       server.URL,
+		Cert:      "/nonexistent/cert",
+		Key:       "/nonexistent/key",
+		CertPem:   string(certPem),
+		KeyPem:    string(keyPem),
+		CaCertPem: string(caPem),
+	})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	domain, err := c.GetDomain(context.Background(), "sys.auth")
+	if err != nil {
+		t.Fatalf("GetDomain() error = %v", err)
+	}
+	if domain.Name != "inline" {
+		t.Fatalf("server saw certificate %s, want inline", domain.Name)
+	}
+}
+
+func TestClientWithFilesAndInlineCaCert(t *testing.T) {
+	server, caFile := newTestTLSServer(t)
+	caPem, err := os.ReadFile(caFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certFile, keyFile := writeTestKeyPair(t, t.TempDir(), "files", time.Now().Add(time.Hour), time.Now())
+
+	c, err := NewClient(&ZmsConfig{
+		Url:       server.URL,
+		Cert:      certFile,
+		Key:       keyFile,
+		CaCert:    "/nonexistent/ca",
+		CaCertPem: string(caPem),
+	})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	if _, err := c.GetDomain(context.Background(), "sys.auth"); err != nil {
+		t.Fatalf("GetDomain() error = %v", err)
+	}
+}
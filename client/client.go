@@ -1,10 +1,14 @@
 package client
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"net/url"
 	"os"
 	"time"
 
@@ -19,53 +23,86 @@ const (
 	ErrCodeRateLimit = 429
 )
 
-var retryDelays = []time.Duration{
-	3 * time.Second,
-	5 * time.Second,
-	7 * time.Second,
-}
-
 type ZmsClient interface {
-	GetRole(domain string, roleName string) (*zms.Role, error)
-	DeleteRole(domain string, roleName string, auditRef string) error
-	PutRole(domain string, roleName string, auditRef string, role *zms.Role) error
-	PutMembership(domain string, roleName string, memberName zms.MemberName, auditRef string, membership *zms.Membership) error
-	DeleteMembership(domain string, roleMember string, member zms.MemberName, auditRef string) error
-	PutPolicy(domain string, policyName string, auditRef string, policy *zms.Policy) error
-	GetPolicy(domain string, policy string) (*zms.Policy, error)
-	DeletePolicy(domain string, policyName string, auditRef string) error
-	GetGroup(domain string, groupName string) (*zms.Group, error)
-	DeleteGroup(domain string, groupName string, auditRef string) error
-	PutGroup(domain string, groupName string, auditRef string, group *zms.Group) error
-	DeleteGroupMembership(domain string, groupName string, member zms.GroupMemberName, auditRef string) error
-	PutGroupMembership(domain string, groupName string, memberName zms.GroupMemberName, auditRef string, membership *zms.GroupMembership) error
-	GetServiceIdentity(domain string, serviceName string) (*zms.ServiceIdentity, error)
-	PutServiceIdentity(domain string, serviceName string, auditRef string, detail *zms.ServiceIdentity) error
-	DeleteServiceIdentity(domain string, serviceName string, auditRef string) error
-	GetDomain(domainName string) (*zms.Domain, error)
-	PostUserDomain(domainName string, auditRef string, detail *zms.UserDomain) (*zms.Domain, error)
-	DeleteUserDomain(domainName string, auditRef string) error
-	PostSubDomain(parentDomain string, auditRef string, detail *zms.SubDomain) (*zms.Domain, error)
-	DeleteSubDomain(parentDomain string, subDomainName string, auditRef string) error
-	PostTopLevelDomain(auditRef string, detail *zms.TopLevelDomain) (*zms.Domain, error)
-	DeleteTopLevelDomain(name string, auditRef string) error
-	PutDomainMeta(name string, auditRef string, detail *zms.DomainMeta) error
-	GetRoleList(domainName string, limit *int32, skip string) (*zms.RoleList, error)
-	GetPolicyList(domainName string, limit *int32, skip string) (*zms.PolicyList, error)
-	GetServiceIdentityList(domainName string, limit *int32, skip string) (*zms.ServiceIdentityList, error)
-	GetGroups(domainName string, members *bool) (*zms.Groups, error)
-	GetRoles(domainName string, members *bool, tagKey string, tagValue string) (*zms.Roles, error)
-	PutPolicyVersion(domainName string, policyName string, policyOptions *zms.PolicyOptions, auditRef string) error
-	PutAssertionPolicyVersion(domainName string, policyName string, version string, auditRef string, assertion *zms.Assertion) (*zms.Assertion, error)
-	GetPolicyVersion(domainName string, policyName string, version string) (*zms.Policy, error)
-	SetActivePolicyVersion(domainName string, policyName string, policyOptions *zms.PolicyOptions, auditRef string) error
-	GetPolicyVersionList(domainName string, policyName string) (*zms.PolicyList, error)
-	DeletePolicyVersion(domainName string, policyName string, version string, auditRef string) error
-	DeleteAssertionPolicyVersion(domainName string, policyName string, version string, assertionId int64, auditRef string) error
-	PutAssertionConditions(domainName string, policyName string, assertionId int64, auditRef string, assertionConditions *zms.AssertionConditions) (*zms.AssertionConditions, error)
-	GetPolicies(domainName string, assertions bool, includeNonActive bool) (*zms.Policies, error)
-	PutGroupMeta(domain string, groupName string, auditRef string, group *zms.GroupMeta) error
-	PutRoleMeta(domain string, roleName string, auditRef string, group *zms.RoleMeta) error
+	GetRole(ctx context.Context, domain string, roleName string) (*zms.Role, error)
+	DeleteRole(ctx context.Context, domain string, roleName string, auditRef string) error
+	PutRole(ctx context.Context, domain string, roleName string, auditRef string, role *zms.Role) error
+	PutMembership(ctx context.Context, domain string, roleName string, memberName zms.MemberName, auditRef string, membership *zms.Membership) error
+	DeleteMembership(ctx context.Context, domain string, roleMember string, member zms.MemberName, auditRef string) error
+	PutPolicy(ctx context.Context, domain string, policyName string, auditRef string, policy *zms.Policy) error
+	GetPolicy(ctx context.Context, domain string, policy string) (*zms.Policy, error)
+	DeletePolicy(ctx context.Context, domain string, policyName string, auditRef string) error
+	GetGroup(ctx context.Context, domain string, groupName string) (*zms.Group, error)
+	DeleteGroup(ctx context.Context, domain string, groupName string, auditRef string) error
+	PutGroup(ctx context.Context, domain string, groupName string, auditRef string, group *zms.Group) error
+	DeleteGroupMembership(ctx context.Context, domain string, groupName string, member zms.GroupMemberName, auditRef string) error
+	PutGroupMembership(ctx context.Context, domain string, groupName string, memberName zms.GroupMemberName, auditRef string, membership *zms.GroupMembership) error
+	GetServiceIdentity(ctx context.Context, domain string, serviceName string) (*zms.ServiceIdentity, error)
+	PutServiceIdentity(ctx context.Context, domain string, serviceName string, auditRef string, detail *zms.ServiceIdentity) error
+	DeleteServiceIdentity(ctx context.Context, domain string, serviceName string, auditRef string) error
+	GetDomain(ctx context.Context, domainName string) (*zms.Domain, error)
+	PostUserDomain(ctx context.Context, domainName string, auditRef string, detail *zms.UserDomain) (*zms.Domain, error)
+	DeleteUserDomain(ctx context.Context, domainName string, auditRef string) error
+	PostSubDomain(ctx context.Context, parentDomain string, auditRef string, detail *zms.SubDomain) (*zms.Domain, error)
+	DeleteSubDomain(ctx context.Context, parentDomain string, subDomainName string, auditRef string) error
+	PostTopLevelDomain(ctx context.Context, auditRef string, detail *zms.TopLevelDomain) (*zms.Domain, error)
+	DeleteTopLevelDomain(ctx context.Context, name string, auditRef string) error
+	PutDomainMeta(ctx context.Context, name string, auditRef string, detail *zms.DomainMeta) error
+	GetRoleList(ctx context.Context, domainName string, limit *int32, skip string) (*zms.RoleList, error)
+	GetPolicyList(ctx context.Context, domainName string, limit *int32, skip string) (*zms.PolicyList, error)
+	GetServiceIdentityList(ctx context.Context, domainName string, limit *int32, skip string) (*zms.ServiceIdentityList, error)
+	GetGroups(ctx context.Context, domainName string, members *bool, tagKey string, tagValue string) (*zms.Groups, error)
+	GetRoles(ctx context.Context, domainName string, members *bool, tagKey string, tagValue string) (*zms.Roles, error)
+	PutPolicyVersion(ctx context.Context, domainName string, policyName string, policyOptions *zms.PolicyOptions, auditRef string) error
+	PutAssertionPolicyVersion(ctx context.Context, domainName string, policyName string, version string, auditRef string, assertion *zms.Assertion) (*zms.Assertion, error)
+	GetPolicyVersion(ctx context.Context, domainName string, policyName string, version string) (*zms.Policy, error)
+	SetActivePolicyVersion(ctx context.Context, domainName string, policyName string, policyOptions *zms.PolicyOptions, auditRef string) error
+	GetPolicyVersionList(ctx context.Context, domainName string, policyName string) (*zms.PolicyList, error)
+	DeletePolicyVersion(ctx context.Context, domainName string, policyName string, version string, auditRef string) error
+	DeleteAssertionPolicyVersion(ctx context.Context, domainName string, policyName string, version string, assertionId int64, auditRef string) error
+	PutAssertionConditions(ctx context.Context, domainName string, policyName string, assertionId int64, auditRef string, assertionConditions *zms.AssertionConditions) (*zms.AssertionConditions, error)
+	GetPolicies(ctx context.Context, domainName string, assertions bool, includeNonActive bool, tagKey string, tagValue string) (*zms.Policies, error)
+	PutGroupMeta(ctx context.Context, domain string, groupName string, auditRef string, group *zms.GroupMeta) error
+	PutRoleMeta(ctx context.Context, domain string, roleName string, auditRef string, group *zms.RoleMeta) error
+	GetDomainTemplateDetailsList(ctx context.Context, domainName string) (*zms.DomainTemplateDetailsList, error)
+	GetServerTemplateDetailsList(ctx context.Context) (*zms.DomainTemplateDetailsList, error)
+	PutDomainTemplate(ctx context.Context, domainName string, templateName string, auditRef string, template *zms.DomainTemplate) error
+	DeleteDomainTemplate(ctx context.Context, domainName string, templateName string, auditRef string) error
+	GetTemplate(ctx context.Context, templateName string) (*zms.Template, error)
+	GetEntity(ctx context.Context, domainName string, entityName string) (*zms.Entity, error)
+	GetEntityList(ctx context.Context, domainName string) (*zms.EntityList, error)
+	PutEntity(ctx context.Context, domainName string, entityName string, auditRef string, entity *zms.Entity) error
+	DeleteEntity(ctx context.Context, domainName string, entityName string, auditRef string) error
+	GetQuota(ctx context.Context, domainName string) (*zms.Quota, error)
+	PutQuota(ctx context.Context, domainName string, auditRef string, quota *zms.Quota) error
+	DeleteQuota(ctx context.Context, domainName string, auditRef string) error
+	GetMembership(ctx context.Context, domain string, roleName string, memberName zms.MemberName) (*zms.Membership, error)
+	DeletePendingMembership(ctx context.Context, domain string, roleName string, memberName zms.MemberName, auditRef string) error
+	GetGroupMembership(ctx context.Context, domain string, groupName string, memberName zms.GroupMemberName) (*zms.GroupMembership, error)
+	DeletePendingGroupMembership(ctx context.Context, domain string, groupName string, memberName zms.GroupMemberName, auditRef string) error
+	GetRoleWithPendingMembers(ctx context.Context, domain string, roleName string) (*zms.Role, error)
+	GetGroupWithPendingMembers(ctx context.Context, domain string, groupName string) (*zms.Group, error)
+	PutMembershipDecision(ctx context.Context, domain string, roleName string, memberName zms.MemberName, auditRef string, membership *zms.Membership) error
+	PutRoleReview(ctx context.Context, domain string, roleName string, auditRef string, role *zms.Role) error
+	GetServiceIdentities(ctx context.Context, domain string, publicKeys bool, hosts bool, tagKey string, tagValue string) (*zms.ServiceIdentities, error)
+	GetPublicKeyEntry(ctx context.Context, domain string, serviceName string, keyId string) (*zms.PublicKeyEntry, error)
+	PutPublicKeyEntry(ctx context.Context, domain string, serviceName string, keyId string, auditRef string, publicKeyEntry *zms.PublicKeyEntry) error
+	DeletePublicKeyEntry(ctx context.Context, domain string, serviceName string, keyId string, auditRef string) error
+	PutServiceIdentitySystemMeta(ctx context.Context, domain string, serviceName string, attribute string, auditRef string, meta *zms.ServiceIdentitySystemMeta) error
+	PutServiceCredsEntry(ctx context.Context, domain string, serviceName string, auditRef string, creds *zms.CredsEntry) error
+	PutTenancy(ctx context.Context, domain string, providerService string, auditRef string, tenancy *zms.Tenancy) error
+	DeleteTenancy(ctx context.Context, domain string, providerService string, auditRef string) error
+	GetProviderResourceGroupRoles(ctx context.Context, domain string, providerDomain string, providerService string, resourceGroup string) (*zms.ProviderResourceGroupRoles, error)
+	PutProviderResourceGroupRoles(ctx context.Context, domain string, providerDomain string, providerService string, resourceGroup string, auditRef string, roles *zms.ProviderResourceGroupRoles) error
+	DeleteProviderResourceGroupRoles(ctx context.Context, domain string, providerDomain string, providerService string, resourceGroup string, auditRef string) error
+	PutDomainDependency(ctx context.Context, domain string, service string, auditRef string) error
+	DeleteDomainDependency(ctx context.Context, domain string, service string, auditRef string) error
+	GetDependentServiceList(ctx context.Context, domain string) (*zms.ServiceIdentityList, error)
+	GetDependentDomainList(ctx context.Context, service string) (*zms.DomainList, error)
+	GetDomainList(ctx context.Context, filter DomainListFilter, skip string) (*zms.DomainList, error)
+	GetPrincipalRoles(ctx context.Context, principal string, domain string, expand bool) (*zms.DomainRoleMember, error)
+	GetPrincipalGroups(ctx context.Context, principal string, domain string) (*zms.DomainGroupMember, error)
+	GetHostServices(ctx context.Context, host string) (*HostServices, error)
 	GetRoleMetaResourceState(roleMetaResourceState, requestedState int) bool
 	GetGroupMetaResourceState(groupMetaResourceState, requestedState int) bool
 }
@@ -76,717 +113,704 @@ type Client struct {
 	ResourceOwner          string
 	RoleMetaResourceState  int
 	GroupMetaResourceState int
+	retryPolicy            retryPolicy
+	limiter                *requestLimiter
+	zmsClient              zms.ZMSClient
+	certs                  *certReloader
+	endpoints              *endpointPool
+}
+
+// DomainListFilter holds the filters of the ZMS domain list API, empty
+// values are not sent. ModifiedSince is an HTTP date.
+type DomainListFilter struct {
+	Prefix          string
+	Depth           *int32
+	Account         string
+	ProductId       string
+	RoleMember      string
+	RoleName        string
+	TagKey          string
+	TagValue        string
+	BusinessService string
+	ModifiedSince   string
+}
+
+// HostServices lists the services a host is registered on, the pinned zms
+// client has no model for it.
+type HostServices struct {
+	Host  string   `json:"host"`
+	Names []string `json:"names"`
 }
 
 type ZmsConfig struct {
 	Url                    string
+	Urls                   []string
 	Cert                   string
 	Key                    string
 	CaCert                 string
+	CertPem                string
+	KeyPem                 string
+	CaCertPem              string
+	AuthMode               string
+	AccessToken            string
+	AccessTokenFile        string
+	ZtsUrl                 string
+	AccessTokenScope       string
+	OidcToken              string
+	OidcTokenFile          string
+	ProxyUrl               string
+	NoProxy                string
+	Headers                map[string]string
+	UserAgent              string
 	ResourceOwner          string
 	RoleMetaResourceState  int
 	GroupMetaResourceState int
+	MaxRetries             int
+	RetryMaxWait           time.Duration
+	RetryOnStatus          []int
+	RequestsPerSecond      float64
+	MaxConcurrentRequests  int
+	ConnectionPoolSize     int
+	RequestTimeout         time.Duration
+	TLSHandshakeTimeout    time.Duration
+	CertReloadInterval     time.Duration
+}
+
+func (c Client) GetPolicies(ctx context.Context, domainName string, assertions bool, includeNonActive bool, tagKey string, tagValue string) (*zms.Policies, error) {
+	var policies *zms.Policies
+	err := c.retry(ctx, "GetPolicies", true, func(zmsClient zms.ZMSClient) (err error) {
+		policies, err = zmsClient.GetPolicies(zms.DomainName(domainName), &assertions, &includeNonActive, zms.TagKey(tagKey), zms.TagCompoundValue(tagValue))
+		return err
+	})
+	return policies, err
 }
 
-func (c Client) GetPolicies(domainName string, assertions bool, includeNonActive bool) (*zms.Policies, error) {
-	var (
-		policies *zms.Policies
-		err      error
-	)
-	zmsClient := zms.NewClient(c.Url, c.Transport)
-	for _, delay := range append([]time.Duration{0}, retryDelays...) {
-		if delay > 0 {
-			time.Sleep(delay)
-		}
-		policies, err = zmsClient.GetPolicies(zms.DomainName(domainName), &assertions, &includeNonActive, "", "")
-		if errObj, ok := err.(rdl.ResourceError); ok && errObj.Code == ErrCodeRateLimit {
-			continue
-		}
-		return policies, err
-	}
-	return nil, fmt.Errorf("too many requests, retried 3 times but still failed: %w", err)
-}
-
-func (c Client) DeletePolicyVersion(domainName string, policyName string, version string, auditRef string) error {
-	var err error
-	zmsClient := zms.NewClient(c.Url, c.Transport)
-	for _, delay := range append([]time.Duration{0}, retryDelays...) {
-		if delay > 0 {
-			time.Sleep(delay)
-		}
-		err = zmsClient.DeletePolicyVersion(zms.DomainName(domainName), zms.EntityName(policyName), zms.SimpleName(version), auditRef, c.ResourceOwner)
-		if errObj, ok := err.(rdl.ResourceError); ok && errObj.Code == ErrCodeRateLimit {
-			continue
-		}
-		return err
-	}
-	return fmt.Errorf("too many requests, retried 3 times but still failed: %w", err)
+func (c Client) DeletePolicyVersion(ctx context.Context, domainName string, policyName string, version string, auditRef string) error {
+	return c.retry(ctx, "DeletePolicyVersion", false, func(zmsClient zms.ZMSClient) error {
+		return zmsClient.DeletePolicyVersion(zms.DomainName(domainName), zms.EntityName(policyName), zms.SimpleName(version), auditRef, c.ResourceOwner)
+	})
 }
 
-func (c Client) SetActivePolicyVersion(domainName string, policyName string, policyOptions *zms.PolicyOptions, auditRef string) error {
-	var err error
-	zmsClient := zms.NewClient(c.Url, c.Transport)
-	for _, delay := range append([]time.Duration{0}, retryDelays...) {
-		if delay > 0 {
-			time.Sleep(delay)
-		}
-		err = zmsClient.SetActivePolicyVersion(zms.DomainName(domainName), zms.EntityName(policyName), policyOptions, auditRef, c.ResourceOwner)
-		if errObj, ok := err.(rdl.ResourceError); ok && errObj.Code == ErrCodeRateLimit {
-			continue
-		}
-		return err
-	}
-	return fmt.Errorf("too many requests, retried 3 times but still failed: %w", err)
+func (c Client) SetActivePolicyVersion(ctx context.Context, domainName string, policyName string, policyOptions *zms.PolicyOptions, auditRef string) error {
+	return c.retry(ctx, "SetActivePolicyVersion", false, func(zmsClient zms.ZMSClient) error {
+		return zmsClient.SetActivePolicyVersion(zms.DomainName(domainName), zms.EntityName(policyName), policyOptions, auditRef, c.ResourceOwner)
+	})
 }
 
-func (c Client) PutPolicyVersion(domainName string, policyName string, policyOptions *zms.PolicyOptions, auditRef string) error {
-	var err error
+func (c Client) PutPolicyVersion(ctx context.Context, domainName string, policyName string, policyOptions *zms.PolicyOptions, auditRef string) error {
 	retObject := false
-	zmsClient := zms.NewClient(c.Url, c.Transport)
-	for _, delay := range append([]time.Duration{0}, retryDelays...) {
-		if delay > 0 {
-			time.Sleep(delay)
-		}
-		_, err = zmsClient.PutPolicyVersion(zms.DomainName(domainName), zms.EntityName(policyName), policyOptions, auditRef, &retObject, c.ResourceOwner)
-		if errObj, ok := err.(rdl.ResourceError); ok && errObj.Code == ErrCodeRateLimit {
-			continue
-		}
+	return c.retry(ctx, "PutPolicyVersion", false, func(zmsClient zms.ZMSClient) error {
+		_, err := zmsClient.PutPolicyVersion(zms.DomainName(domainName), zms.EntityName(policyName), policyOptions, auditRef, &retObject, c.ResourceOwner)
 		return err
-	}
-	return fmt.Errorf("too many requests, retried 3 times but still failed: %w", err)
+	})
 }
 
-func (c Client) GetPolicyVersion(domainName string, policyName string, version string) (*zms.Policy, error) {
-	var (
-		policy *zms.Policy
-		err    error
-	)
-	zmsClient := zms.NewClient(c.Url, c.Transport)
-	for _, delay := range append([]time.Duration{0}, retryDelays...) {
-		if delay > 0 {
-			time.Sleep(delay)
-		}
+func (c Client) GetPolicyVersion(ctx context.Context, domainName string, policyName string, version string) (*zms.Policy, error) {
+	var policy *zms.Policy
+	err := c.retry(ctx, "GetPolicyVersion", true, func(zmsClient zms.ZMSClient) (err error) {
 		policy, err = zmsClient.GetPolicyVersion(zms.DomainName(domainName), zms.EntityName(policyName), zms.SimpleName(version))
-		if errObj, ok := err.(rdl.ResourceError); ok && errObj.Code == ErrCodeRateLimit {
-			continue
-		}
-		return policy, err
-	}
-	return nil, fmt.Errorf("too many requests, retried 3 times but still failed: %w", err)
+		return err
+	})
+	return policy, err
 }
 
-func (c Client) GetPolicyVersionList(domainName string, policyName string) (*zms.PolicyList, error) {
-	var (
-		policyList *zms.PolicyList
-		err        error
-	)
-	zmsClient := zms.NewClient(c.Url, c.Transport)
-	for _, delay := range append([]time.Duration{0}, retryDelays...) {
-		if delay > 0 {
-			time.Sleep(delay)
-		}
+func (c Client) GetPolicyVersionList(ctx context.Context, domainName string, policyName string) (*zms.PolicyList, error) {
+	var policyList *zms.PolicyList
+	err := c.retry(ctx, "GetPolicyVersionList", true, func(zmsClient zms.ZMSClient) (err error) {
 		policyList, err = zmsClient.GetPolicyVersionList(zms.DomainName(domainName), zms.EntityName(policyName))
-		if errObj, ok := err.(rdl.ResourceError); ok && errObj.Code == ErrCodeRateLimit {
-			continue
-		}
-		return policyList, err
-	}
-	return nil, fmt.Errorf("too many requests, retried 3 times but still failed: %w", err)
+		return err
+	})
+	return policyList, err
 }
 
-func (c Client) DeleteAssertionPolicyVersion(domainName string, policyName string, version string, assertionId int64, auditRef string) error {
-	var err error
-	zmsClient := zms.NewClient(c.Url, c.Transport)
-	for _, delay := range append([]time.Duration{0}, retryDelays...) {
-		if delay > 0 {
-			time.Sleep(delay)
-		}
-		err = zmsClient.DeleteAssertionPolicyVersion(zms.DomainName(domainName), zms.EntityName(policyName), zms.SimpleName(version), assertionId, auditRef, c.ResourceOwner)
-		if errObj, ok := err.(rdl.ResourceError); ok && errObj.Code == ErrCodeRateLimit {
-			continue
-		}
-		return err
-	}
-	return fmt.Errorf("too many requests, retried 3 times but still failed: %w", err)
+func (c Client) DeleteAssertionPolicyVersion(ctx context.Context, domainName string, policyName string, version string, assertionId int64, auditRef string) error {
+	return c.retry(ctx, "DeleteAssertionPolicyVersion", false, func(zmsClient zms.ZMSClient) error {
+		return zmsClient.DeleteAssertionPolicyVersion(zms.DomainName(domainName), zms.EntityName(policyName), zms.SimpleName(version), assertionId, auditRef, c.ResourceOwner)
+	})
 }
 
-func (c Client) PutAssertionPolicyVersion(domainName string, policyName string, version string, auditRef string, assertion *zms.Assertion) (*zms.Assertion, error) {
-	var (
-		retAssertion *zms.Assertion
-		err          error
-	)
-	zmsClient := zms.NewClient(c.Url, c.Transport)
-	for _, delay := range append([]time.Duration{0}, retryDelays...) {
-		if delay > 0 {
-			time.Sleep(delay)
-		}
+func (c Client) PutAssertionPolicyVersion(ctx context.Context, domainName string, policyName string, version string, auditRef string, assertion *zms.Assertion) (*zms.Assertion, error) {
+	var retAssertion *zms.Assertion
+	err := c.retry(ctx, "PutAssertionPolicyVersion", false, func(zmsClient zms.ZMSClient) (err error) {
 		retAssertion, err = zmsClient.PutAssertionPolicyVersion(zms.DomainName(domainName), zms.EntityName(policyName), zms.SimpleName(version), auditRef, c.ResourceOwner, assertion)
-		if errObj, ok := err.(rdl.ResourceError); ok && errObj.Code == ErrCodeRateLimit {
-			continue
-		}
-		return retAssertion, err
-	}
-	return nil, fmt.Errorf("too many requests, retried 3 times but still failed: %w", err)
+		return err
+	})
+	return retAssertion, err
 }
 
-func (c Client) GetGroups(domainName string, members *bool) (*zms.Groups, error) {
-	var (
-		groups *zms.Groups
-		err    error
-	)
-	zmsClient := zms.NewClient(c.Url, c.Transport)
-	for _, delay := range append([]time.Duration{0}, retryDelays...) {
-		if delay > 0 {
-			time.Sleep(delay)
-		}
-		groups, err = zmsClient.GetGroups(zms.DomainName(domainName), members, "", "")
-		if errObj, ok := err.(rdl.ResourceError); ok && errObj.Code == ErrCodeRateLimit {
-			continue
-		}
-		return groups, err
-	}
-	return nil, fmt.Errorf("too many requests, retried 3 times but still failed: %w", err)
+func (c Client) GetGroups(ctx context.Context, domainName string, members *bool, tagKey string, tagValue string) (*zms.Groups, error) {
+	var groups *zms.Groups
+	err := c.retry(ctx, "GetGroups", true, func(zmsClient zms.ZMSClient) (err error) {
+		groups, err = zmsClient.GetGroups(zms.DomainName(domainName), members, zms.TagKey(tagKey), zms.TagCompoundValue(tagValue))
+		return err
+	})
+	return groups, err
 }
 
-func (c Client) GetServiceIdentityList(domainName string, limit *int32, skip string) (*zms.ServiceIdentityList, error) {
-	var (
-		serviceIdentityList *zms.ServiceIdentityList
-		err                 error
-	)
-	zmsClient := zms.NewClient(c.Url, c.Transport)
-	for _, delay := range append([]time.Duration{0}, retryDelays...) {
-		if delay > 0 {
-			time.Sleep(delay)
-		}
+func (c Client) GetServiceIdentityList(ctx context.Context, domainName string, limit *int32, skip string) (*zms.ServiceIdentityList, error) {
+	var serviceIdentityList *zms.ServiceIdentityList
+	err := c.retry(ctx, "GetServiceIdentityList", true, func(zmsClient zms.ZMSClient) (err error) {
 		serviceIdentityList, err = zmsClient.GetServiceIdentityList(zms.DomainName(domainName), limit, skip)
-		if errObj, ok := err.(rdl.ResourceError); ok && errObj.Code == ErrCodeRateLimit {
-			continue
-		}
-		return serviceIdentityList, err
-	}
-	return nil, fmt.Errorf("too many requests, retried 3 times but still failed: %w", err)
+		return err
+	})
+	return serviceIdentityList, err
 }
 
-func (c Client) GetPolicyList(domainName string, limit *int32, skip string) (*zms.PolicyList, error) {
-	var (
-		policyList *zms.PolicyList
-		err        error
-	)
-	zmsClient := zms.NewClient(c.Url, c.Transport)
-	for _, delay := range append([]time.Duration{0}, retryDelays...) {
-		if delay > 0 {
-			time.Sleep(delay)
-		}
+func (c Client) GetPolicyList(ctx context.Context, domainName string, limit *int32, skip string) (*zms.PolicyList, error) {
+	var policyList *zms.PolicyList
+	err := c.retry(ctx, "GetPolicyList", true, func(zmsClient zms.ZMSClient) (err error) {
 		policyList, err = zmsClient.GetPolicyList(zms.DomainName(domainName), limit, skip)
-		if errObj, ok := err.(rdl.ResourceError); ok && errObj.Code == ErrCodeRateLimit {
-			continue
-		}
-		return policyList, err
-	}
-	return nil, fmt.Errorf("too many requests, retried 3 times but still failed: %w", err)
+		return err
+	})
+	return policyList, err
 }
 
-func (c Client) GetRoles(domainName string, members *bool, tagKey string, tagValue string) (*zms.Roles, error) {
-	var (
-		roles *zms.Roles
-		err   error
-	)
-	zmsClient := zms.NewClient(c.Url, c.Transport)
-	for _, delay := range append([]time.Duration{0}, retryDelays...) {
-		if delay > 0 {
-			time.Sleep(delay)
-		}
+func (c Client) GetRoles(ctx context.Context, domainName string, members *bool, tagKey string, tagValue string) (*zms.Roles, error) {
+	var roles *zms.Roles
+	err := c.retry(ctx, "GetRoles", true, func(zmsClient zms.ZMSClient) (err error) {
 		roles, err = zmsClient.GetRoles(zms.DomainName(domainName), members, zms.TagKey(tagKey), zms.TagCompoundValue(tagValue))
-		if errObj, ok := err.(rdl.ResourceError); ok && errObj.Code == ErrCodeRateLimit {
-			continue
-		}
-		return roles, err
-	}
-	return nil, fmt.Errorf("too many requests, retried 3 times but still failed: %w", err)
+		return err
+	})
+	return roles, err
 }
 
-func (c Client) GetRoleList(domainName string, limit *int32, skip string) (*zms.RoleList, error) {
-	var (
-		roleList *zms.RoleList
-		err      error
-	)
-	zmsClient := zms.NewClient(c.Url, c.Transport)
-	for _, delay := range append([]time.Duration{0}, retryDelays...) {
-		if delay > 0 {
-			time.Sleep(delay)
-		}
+func (c Client) GetRoleList(ctx context.Context, domainName string, limit *int32, skip string) (*zms.RoleList, error) {
+	var roleList *zms.RoleList
+	err := c.retry(ctx, "GetRoleList", true, func(zmsClient zms.ZMSClient) (err error) {
 		roleList, err = zmsClient.GetRoleList(zms.DomainName(domainName), limit, skip)
-		if errObj, ok := err.(rdl.ResourceError); ok && errObj.Code == ErrCodeRateLimit {
-			continue
-		}
-		return roleList, err
-	}
-	return nil, fmt.Errorf("too many requests, retried 3 times but still failed: %w", err)
+		return err
+	})
+	return roleList, err
 }
 
-func (c Client) PutDomainMeta(name string, auditRef string, detail *zms.DomainMeta) error {
-	var err error
-	zmsClient := zms.NewClient(c.Url, c.Transport)
-	for _, delay := range append([]time.Duration{0}, retryDelays...) {
-		if delay > 0 {
-			time.Sleep(delay)
-		}
-		err = zmsClient.PutDomainMeta(zms.DomainName(name), auditRef, c.ResourceOwner, detail)
-		if errObj, ok := err.(rdl.ResourceError); ok && errObj.Code == ErrCodeRateLimit {
-			continue
-		}
-		return err
-	}
-	return fmt.Errorf("too many requests, retried 3 times but still failed: %w", err)
+func (c Client) PutDomainMeta(ctx context.Context, name string, auditRef string, detail *zms.DomainMeta) error {
+	return c.retry(ctx, "PutDomainMeta", false, func(zmsClient zms.ZMSClient) error {
+		return zmsClient.PutDomainMeta(zms.DomainName(name), auditRef, c.ResourceOwner, detail)
+	})
 }
 
-func (c Client) PostTopLevelDomain(auditRef string, detail *zms.TopLevelDomain) (*zms.Domain, error) {
-	var (
-		domain *zms.Domain
-		err    error
-	)
-	zmsClient := zms.NewClient(c.Url, c.Transport)
-	for _, delay := range append([]time.Duration{0}, retryDelays...) {
-		if delay > 0 {
-			time.Sleep(delay)
-		}
+func (c Client) PostTopLevelDomain(ctx context.Context, auditRef string, detail *zms.TopLevelDomain) (*zms.Domain, error) {
+	var domain *zms.Domain
+	err := c.retry(ctx, "PostTopLevelDomain", false, func(zmsClient zms.ZMSClient) (err error) {
 		domain, err = zmsClient.PostTopLevelDomain(auditRef, c.ResourceOwner, detail)
-		if errObj, ok := err.(rdl.ResourceError); ok && errObj.Code == ErrCodeRateLimit {
-			continue
-		}
-		return domain, err
-	}
-	return nil, fmt.Errorf("too many requests, retried 3 times but still failed: %w", err)
+		return err
+	})
+	return domain, err
 }
 
-func (c Client) DeleteTopLevelDomain(name string, auditRef string) error {
-	var err error
-	zmsClient := zms.NewClient(c.Url, c.Transport)
-	for _, delay := range append([]time.Duration{0}, retryDelays...) {
-		if delay > 0 {
-			time.Sleep(delay)
-		}
-		err = zmsClient.DeleteTopLevelDomain(zms.SimpleName(name), auditRef, c.ResourceOwner)
-		if errObj, ok := err.(rdl.ResourceError); ok && errObj.Code == ErrCodeRateLimit {
-			continue
-		}
-		return err
-	}
-	return fmt.Errorf("too many requests, retried 3 times but still failed: %w", err)
+func (c Client) DeleteTopLevelDomain(ctx context.Context, name string, auditRef string) error {
+	return c.retry(ctx, "DeleteTopLevelDomain", false, func(zmsClient zms.ZMSClient) error {
+		return zmsClient.DeleteTopLevelDomain(zms.SimpleName(name), auditRef, c.ResourceOwner)
+	})
 }
 
-func (c Client) DeleteSubDomain(parentDomain string, subDomainName string, auditRef string) error {
-	var err error
-	zmsClient := zms.NewClient(c.Url, c.Transport)
-	for _, delay := range append([]time.Duration{0}, retryDelays...) {
-		if delay > 0 {
-			time.Sleep(delay)
-		}
-		err = zmsClient.DeleteSubDomain(zms.DomainName(parentDomain), zms.SimpleName(subDomainName), auditRef, c.ResourceOwner)
-		if errObj, ok := err.(rdl.ResourceError); ok && errObj.Code == ErrCodeRateLimit {
-			continue
-		}
-		return err
-	}
-	return fmt.Errorf("too many requests, retried 3 times but still failed: %w", err)
+func (c Client) DeleteSubDomain(ctx context.Context, parentDomain string, subDomainName string, auditRef string) error {
+	return c.retry(ctx, "DeleteSubDomain", false, func(zmsClient zms.ZMSClient) error {
+		return zmsClient.DeleteSubDomain(zms.DomainName(parentDomain), zms.SimpleName(subDomainName), auditRef, c.ResourceOwner)
+	})
 }
 
-func (c Client) PostSubDomain(parentDomain string, auditRef string, detail *zms.SubDomain) (*zms.Domain, error) {
-	var (
-		domain *zms.Domain
-		err    error
-	)
-	zmsClient := zms.NewClient(c.Url, c.Transport)
-	for _, delay := range append([]time.Duration{0}, retryDelays...) {
-		if delay > 0 {
-			time.Sleep(delay)
-		}
+func (c Client) PostSubDomain(ctx context.Context, parentDomain string, auditRef string, detail *zms.SubDomain) (*zms.Domain, error) {
+	var domain *zms.Domain
+	err := c.retry(ctx, "PostSubDomain", false, func(zmsClient zms.ZMSClient) (err error) {
 		domain, err = zmsClient.PostSubDomain(zms.DomainName(parentDomain), auditRef, c.ResourceOwner, detail)
-		if errObj, ok := err.(rdl.ResourceError); ok && errObj.Code == ErrCodeRateLimit {
-			continue
-		}
-		return domain, err
-	}
-	return nil, fmt.Errorf("too many requests, retried 3 times but still failed: %w", err)
+		return err
+	})
+	return domain, err
 }
 
-func (c Client) DeleteUserDomain(domainName string, auditRef string) error {
-	var err error
-	zmsClient := zms.NewClient(c.Url, c.Transport)
-	for _, delay := range append([]time.Duration{0}, retryDelays...) {
-		if delay > 0 {
-			time.Sleep(delay)
-		}
-		err = zmsClient.DeleteUserDomain(zms.SimpleName(domainName), auditRef, c.ResourceOwner)
-		if errObj, ok := err.(rdl.ResourceError); ok && errObj.Code == ErrCodeRateLimit {
-			continue
-		}
-		return err
-	}
-	return fmt.Errorf("too many requests, retried 3 times but still failed: %w", err)
+func (c Client) DeleteUserDomain(ctx context.Context, domainName string, auditRef string) error {
+	return c.retry(ctx, "DeleteUserDomain", false, func(zmsClient zms.ZMSClient) error {
+		return zmsClient.DeleteUserDomain(zms.SimpleName(domainName), auditRef, c.ResourceOwner)
+	})
 }
 
-func (c Client) PostUserDomain(domainName string, auditRef string, detail *zms.UserDomain) (*zms.Domain, error) {
-	var (
-		domain *zms.Domain
-		err    error
-	)
-	zmsClient := zms.NewClient(c.Url, c.Transport)
-	for _, delay := range append([]time.Duration{0}, retryDelays...) {
-		if delay > 0 {
-			time.Sleep(delay)
-		}
+func (c Client) PostUserDomain(ctx context.Context, domainName string, auditRef string, detail *zms.UserDomain) (*zms.Domain, error) {
+	var domain *zms.Domain
+	err := c.retry(ctx, "PostUserDomain", false, func(zmsClient zms.ZMSClient) (err error) {
 		domain, err = zmsClient.PostUserDomain(zms.SimpleName(domainName), auditRef, c.ResourceOwner, detail)
-		if errObj, ok := err.(rdl.ResourceError); ok && errObj.Code == ErrCodeRateLimit {
-			continue
-		}
-		return domain, err
-	}
-	return nil, fmt.Errorf("too many requests, retried 3 times but still failed: %w", err)
+		return err
+	})
+	return domain, err
 }
 
-func (c Client) GetDomain(domainName string) (*zms.Domain, error) {
-	var (
-		domain *zms.Domain
-		err    error
-	)
-	zmsClient := zms.NewClient(c.Url, c.Transport)
-	for _, delay := range append([]time.Duration{0}, retryDelays...) {
-		if delay > 0 {
-			time.Sleep(delay)
-		}
+func (c Client) GetDomain(ctx context.Context, domainName string) (*zms.Domain, error) {
+	var domain *zms.Domain
+	err := c.retry(ctx, "GetDomain", true, func(zmsClient zms.ZMSClient) (err error) {
 		domain, err = zmsClient.GetDomain(zms.DomainName(domainName))
-		if errObj, ok := err.(rdl.ResourceError); ok && errObj.Code == ErrCodeRateLimit {
-			continue
-		}
-		return domain, err
-	}
-	return nil, fmt.Errorf("too many requests, retried 3 times but still failed: %w", err)
+		return err
+	})
+	return domain, err
 }
 
-func (c Client) PutServiceIdentity(domain string, serviceName string, auditRef string, detail *zms.ServiceIdentity) error {
-	var err error
+func (c Client) PutServiceIdentity(ctx context.Context, domain string, serviceName string, auditRef string, detail *zms.ServiceIdentity) error {
 	retObject := false
-	zmsClient := zms.NewClient(c.Url, c.Transport)
-	for _, delay := range append([]time.Duration{0}, retryDelays...) {
-		if delay > 0 {
-			time.Sleep(delay)
-		}
-		_, err = zmsClient.PutServiceIdentity(zms.DomainName(domain), zms.SimpleName(serviceName), auditRef, &retObject, c.ResourceOwner, detail)
-		if errObj, ok := err.(rdl.ResourceError); ok && errObj.Code == ErrCodeRateLimit {
-			continue
-		}
+	return c.retry(ctx, "PutServiceIdentity", false, func(zmsClient zms.ZMSClient) error {
+		_, err := zmsClient.PutServiceIdentity(zms.DomainName(domain), zms.SimpleName(serviceName), auditRef, &retObject, c.ResourceOwner, detail)
 		return err
-	}
-	return fmt.Errorf("too many requests, retried 3 times but still failed: %w", err)
+	})
 }
 
-func (c Client) DeleteServiceIdentity(domain string, serviceName string, auditRef string) error {
-	var err error
-	zmsClient := zms.NewClient(c.Url, c.Transport)
-	for _, delay := range append([]time.Duration{0}, retryDelays...) {
-		if delay > 0 {
-			time.Sleep(delay)
-		}
-		err = zmsClient.DeleteServiceIdentity(zms.DomainName(domain), zms.SimpleName(serviceName), auditRef, c.ResourceOwner)
-		if errObj, ok := err.(rdl.ResourceError); ok && errObj.Code == ErrCodeRateLimit {
-			continue
-		}
-		return err
-	}
-	return fmt.Errorf("too many requests, retried 3 times but still failed: %w", err)
+func (c Client) DeleteServiceIdentity(ctx context.Context, domain string, serviceName string, auditRef string) error {
+	return c.retry(ctx, "DeleteServiceIdentity", false, func(zmsClient zms.ZMSClient) error {
+		return zmsClient.DeleteServiceIdentity(zms.DomainName(domain), zms.SimpleName(serviceName), auditRef, c.ResourceOwner)
+	})
 }
 
-func (c Client) GetServiceIdentity(domain string, serviceName string) (*zms.ServiceIdentity, error) {
-	var (
-		serviceIdentity *zms.ServiceIdentity
-		err             error
-	)
-	zmsClient := zms.NewClient(c.Url, c.Transport)
-	for _, delay := range append([]time.Duration{0}, retryDelays...) {
-		if delay > 0 {
-			time.Sleep(delay)
-		}
+func (c Client) GetServiceIdentity(ctx context.Context, domain string, serviceName string) (*zms.ServiceIdentity, error) {
+	var serviceIdentity *zms.ServiceIdentity
+	err := c.retry(ctx, "GetServiceIdentity", true, func(zmsClient zms.ZMSClient) (err error) {
 		serviceIdentity, err = zmsClient.GetServiceIdentity(zms.DomainName(domain), zms.SimpleName(serviceName))
-		if errObj, ok := err.(rdl.ResourceError); ok && errObj.Code == ErrCodeRateLimit {
-			continue
-		}
-		return serviceIdentity, err
-	}
-	return nil, fmt.Errorf("too many requests, retried 3 times but still failed: %w", err)
+		return err
+	})
+	return serviceIdentity, err
 }
 
-func (c Client) PutGroupMembership(domain string, groupName string, memberName zms.GroupMemberName, auditRef string, membership *zms.GroupMembership) error {
-	var err error
+func (c Client) PutGroupMembership(ctx context.Context, domain string, groupName string, memberName zms.GroupMemberName, auditRef string, membership *zms.GroupMembership) error {
 	retObject := false
-	zmsClient := zms.NewClient(c.Url, c.Transport)
-	for _, delay := range append([]time.Duration{0}, retryDelays...) {
-		if delay > 0 {
-			time.Sleep(delay)
-		}
-		_, err = zmsClient.PutGroupMembership(zms.DomainName(domain), zms.EntityName(groupName), memberName, auditRef, &retObject, c.ResourceOwner, membership)
-		if errObj, ok := err.(rdl.ResourceError); ok && errObj.Code == ErrCodeRateLimit {
-			continue
-		}
+	return c.retry(ctx, "PutGroupMembership", false, func(zmsClient zms.ZMSClient) error {
+		_, err := zmsClient.PutGroupMembership(zms.DomainName(domain), zms.EntityName(groupName), memberName, auditRef, &retObject, c.ResourceOwner, membership)
 		return err
-	}
-	return fmt.Errorf("too many requests, retried 3 times but still failed: %w", err)
+	})
 }
 
-func (c Client) DeleteGroupMembership(domain string, groupName string, member zms.GroupMemberName, auditRef string) error {
-	var err error
-	zmsClient := zms.NewClient(c.Url, c.Transport)
-	for _, delay := range append([]time.Duration{0}, retryDelays...) {
-		if delay > 0 {
-			time.Sleep(delay)
-		}
-		err = zmsClient.DeleteGroupMembership(zms.DomainName(domain), zms.EntityName(groupName), member, auditRef, c.ResourceOwner)
-		if errObj, ok := err.(rdl.ResourceError); ok && errObj.Code == ErrCodeRateLimit {
-			continue
-		}
-		return err
-	}
-	return fmt.Errorf("too many requests, retried 3 times but still failed: %w", err)
+func (c Client) DeleteGroupMembership(ctx context.Context, domain string, groupName string, member zms.GroupMemberName, auditRef string) error {
+	return c.retry(ctx, "DeleteGroupMembership", false, func(zmsClient zms.ZMSClient) error {
+		return zmsClient.DeleteGroupMembership(zms.DomainName(domain), zms.EntityName(groupName), member, auditRef, c.ResourceOwner)
+	})
 }
 
-func (c Client) PutGroup(domain string, groupName string, auditRef string, group *zms.Group) error {
-	var err error
+func (c Client) PutGroup(ctx context.Context, domain string, groupName string, auditRef string, group *zms.Group) error {
 	retObject := false
-	zmsClient := zms.NewClient(c.Url, c.Transport)
-	for _, delay := range append([]time.Duration{0}, retryDelays...) {
-		if delay > 0 {
-			time.Sleep(delay)
-		}
-		_, err = zmsClient.PutGroup(zms.DomainName(domain), zms.EntityName(groupName), auditRef, &retObject, c.ResourceOwner, group)
-		if errObj, ok := err.(rdl.ResourceError); ok && errObj.Code == ErrCodeRateLimit {
-			continue
-		}
+	return c.retry(ctx, "PutGroup", false, func(zmsClient zms.ZMSClient) error {
+		_, err := zmsClient.PutGroup(zms.DomainName(domain), zms.EntityName(groupName), auditRef, &retObject, c.ResourceOwner, group)
 		return err
-	}
-	return fmt.Errorf("too many requests, retried 3 times but still failed: %w", err)
+	})
 }
 
-func (c Client) DeleteGroup(domain string, groupName string, auditRef string) error {
-	var err error
-	zmsClient := zms.NewClient(c.Url, c.Transport)
-	for _, delay := range append([]time.Duration{0}, retryDelays...) {
-		if delay > 0 {
-			time.Sleep(delay)
-		}
-		err = zmsClient.DeleteGroup(zms.DomainName(domain), zms.EntityName(groupName), auditRef, c.ResourceOwner)
-		if errObj, ok := err.(rdl.ResourceError); ok && errObj.Code == ErrCodeRateLimit {
-			continue
-		}
-		return err
-	}
-	return fmt.Errorf("too many requests, retried 3 times but still failed: %w", err)
+func (c Client) DeleteGroup(ctx context.Context, domain string, groupName string, auditRef string) error {
+	return c.retry(ctx, "DeleteGroup", false, func(zmsClient zms.ZMSClient) error {
+		return zmsClient.DeleteGroup(zms.DomainName(domain), zms.EntityName(groupName), auditRef, c.ResourceOwner)
+	})
 }
 
-func (c Client) GetGroup(domain string, groupName string) (*zms.Group, error) {
-	var (
-		group *zms.Group
-		err   error
-	)
-	zmsClient := zms.NewClient(c.Url, c.Transport)
-	for _, delay := range append([]time.Duration{0}, retryDelays...) {
-		if delay > 0 {
-			time.Sleep(delay)
-		}
+func (c Client) GetGroup(ctx context.Context, domain string, groupName string) (*zms.Group, error) {
+	var group *zms.Group
+	err := c.retry(ctx, "GetGroup", true, func(zmsClient zms.ZMSClient) (err error) {
 		group, err = zmsClient.GetGroup(zms.DomainName(domain), zms.EntityName(groupName), nil, nil)
-		if errObj, ok := err.(rdl.ResourceError); ok && errObj.Code == ErrCodeRateLimit {
-			continue
-		}
-		return group, err
-	}
-	return nil, fmt.Errorf("too many requests, retried 3 times but still failed: %w", err)
+		return err
+	})
+	return group, err
 }
 
-func (c Client) GetPolicy(domain string, policy string) (*zms.Policy, error) {
-	var (
-		retPolicy *zms.Policy
-		err       error
-	)
-	zmsClient := zms.NewClient(c.Url, c.Transport)
-	for _, delay := range append([]time.Duration{0}, retryDelays...) {
-		if delay > 0 {
-			time.Sleep(delay)
-		}
+func (c Client) GetPolicy(ctx context.Context, domain string, policy string) (*zms.Policy, error) {
+	var retPolicy *zms.Policy
+	err := c.retry(ctx, "GetPolicy", true, func(zmsClient zms.ZMSClient) (err error) {
 		retPolicy, err = zmsClient.GetPolicy(zms.DomainName(domain), zms.EntityName(policy))
-		if errObj, ok := err.(rdl.ResourceError); ok && errObj.Code == ErrCodeRateLimit {
-			continue
-		}
-		return retPolicy, err
-	}
-	return nil, fmt.Errorf("too many requests, retried 3 times but still failed: %w", err)
+		return err
+	})
+	return retPolicy, err
 }
 
-func (c Client) PutPolicy(domain string, policyName string, auditRef string, policy *zms.Policy) error {
-	var err error
+func (c Client) PutPolicy(ctx context.Context, domain string, policyName string, auditRef string, policy *zms.Policy) error {
 	retObject := false
-	zmsClient := zms.NewClient(c.Url, c.Transport)
-	for _, delay := range append([]time.Duration{0}, retryDelays...) {
-		if delay > 0 {
-			time.Sleep(delay)
-		}
-		_, err = zmsClient.PutPolicy(zms.DomainName(domain), zms.EntityName(policyName), auditRef, &retObject, c.ResourceOwner, policy)
-		if errObj, ok := err.(rdl.ResourceError); ok && errObj.Code == ErrCodeRateLimit {
-			continue
-		}
+	return c.retry(ctx, "PutPolicy", false, func(zmsClient zms.ZMSClient) error {
+		_, err := zmsClient.PutPolicy(zms.DomainName(domain), zms.EntityName(policyName), auditRef, &retObject, c.ResourceOwner, policy)
 		return err
-	}
-	return fmt.Errorf("too many requests, retried 3 times but still failed: %w", err)
+	})
 }
 
-func (c Client) DeletePolicy(domain string, policyName string, auditRef string) error {
-	var err error
-	zmsClient := zms.NewClient(c.Url, c.Transport)
-	for _, delay := range append([]time.Duration{0}, retryDelays...) {
-		if delay > 0 {
-			time.Sleep(delay)
-		}
-		err = zmsClient.DeletePolicy(zms.DomainName(domain), zms.EntityName(policyName), auditRef, c.ResourceOwner)
-		if errObj, ok := err.(rdl.ResourceError); ok && errObj.Code == ErrCodeRateLimit {
-			continue
-		}
-		return err
-	}
-	return fmt.Errorf("too many requests, retried 3 times but still failed: %w", err)
+func (c Client) DeletePolicy(ctx context.Context, domain string, policyName string, auditRef string) error {
+	return c.retry(ctx, "DeletePolicy", false, func(zmsClient zms.ZMSClient) error {
+		return zmsClient.DeletePolicy(zms.DomainName(domain), zms.EntityName(policyName), auditRef, c.ResourceOwner)
+	})
 }
 
-func (c Client) PutAssertionConditions(domainName string, policyName string, assertionId int64, auditRef string, assertionConditions *zms.AssertionConditions) (*zms.AssertionConditions, error) {
-	var (
-		retAssertionConditions *zms.AssertionConditions
-		err                    error
-	)
-	zmsClient := zms.NewClient(c.Url, c.Transport)
-	for _, delay := range append([]time.Duration{0}, retryDelays...) {
-		if delay > 0 {
-			time.Sleep(delay)
-		}
+func (c Client) PutAssertionConditions(ctx context.Context, domainName string, policyName string, assertionId int64, auditRef string, assertionConditions *zms.AssertionConditions) (*zms.AssertionConditions, error) {
+	var retAssertionConditions *zms.AssertionConditions
+	err := c.retry(ctx, "PutAssertionConditions", false, func(zmsClient zms.ZMSClient) (err error) {
 		retAssertionConditions, err = zmsClient.PutAssertionConditions(zms.DomainName(domainName), zms.EntityName(policyName), assertionId, auditRef, c.ResourceOwner, assertionConditions)
-		if errObj, ok := err.(rdl.ResourceError); ok && errObj.Code == ErrCodeRateLimit {
-			continue
-		}
-		return retAssertionConditions, err
-	}
-	return nil, fmt.Errorf("too many requests, retried 3 times but still failed: %w", err)
+		return err
+	})
+	return retAssertionConditions, err
 }
 
-func (c Client) GetRole(domain string, roleName string) (*zms.Role, error) {
-	var (
-		role *zms.Role
-		err  error
-	)
-	zmsClient := zms.NewClient(c.Url, c.Transport)
-	for _, delay := range append([]time.Duration{0}, retryDelays...) {
-		if delay > 0 {
-			time.Sleep(delay)
-		}
+func (c Client) GetRole(ctx context.Context, domain string, roleName string) (*zms.Role, error) {
+	var role *zms.Role
+	err := c.retry(ctx, "GetRole", true, func(zmsClient zms.ZMSClient) (err error) {
 		role, err = zmsClient.GetRole(zms.DomainName(domain), zms.EntityName(roleName), nil, nil, nil)
-		if errObj, ok := err.(rdl.ResourceError); ok && errObj.Code == ErrCodeRateLimit {
-			continue
-		}
-		return role, err
-	}
-	return nil, fmt.Errorf("too many requests, retried 3 times but still failed: %w", err)
+		return err
+	})
+	return role, err
 }
 
-func (c Client) PutRole(domain string, roleName string, auditRef string, role *zms.Role) error {
-	var err error
+func (c Client) PutRole(ctx context.Context, domain string, roleName string, auditRef string, role *zms.Role) error {
 	retObject := false
-	zmsClient := zms.NewClient(c.Url, c.Transport)
-	for _, delay := range append([]time.Duration{0}, retryDelays...) {
-		if delay > 0 {
-			time.Sleep(delay)
-		}
-		_, err = zmsClient.PutRole(zms.DomainName(domain), zms.EntityName(roleName), auditRef, &retObject, c.ResourceOwner, role)
-		if errObj, ok := err.(rdl.ResourceError); ok && errObj.Code == ErrCodeRateLimit {
-			continue
-		}
+	return c.retry(ctx, "PutRole", false, func(zmsClient zms.ZMSClient) error {
+		_, err := zmsClient.PutRole(zms.DomainName(domain), zms.EntityName(roleName), auditRef, &retObject, c.ResourceOwner, role)
 		return err
-	}
-	return fmt.Errorf("too many requests, retried 3 times but still failed: %w", err)
+	})
 }
 
-func (c Client) DeleteRole(domain string, roleName string, auditRef string) error {
-	var err error
-	zmsClient := zms.NewClient(c.Url, c.Transport)
-	for _, delay := range append([]time.Duration{0}, retryDelays...) {
-		if delay > 0 {
-			time.Sleep(delay)
-		}
-		err = zmsClient.DeleteRole(zms.DomainName(domain), zms.EntityName(roleName), auditRef, c.ResourceOwner)
-		if errObj, ok := err.(rdl.ResourceError); ok && errObj.Code == ErrCodeRateLimit {
-			continue
-		}
+func (c Client) DeleteRole(ctx context.Context, domain string, roleName string, auditRef string) error {
+	return c.retry(ctx, "DeleteRole", false, func(zmsClient zms.ZMSClient) error {
+		return zmsClient.DeleteRole(zms.DomainName(domain), zms.EntityName(roleName), auditRef, c.ResourceOwner)
+	})
+}
+
+func (c Client) PutMembership(ctx context.Context, domain string, roleName string, memberName zms.MemberName, auditRef string, membership *zms.Membership) error {
+	retObject := false
+	return c.retry(ctx, "PutMembership", false, func(zmsClient zms.ZMSClient) error {
+		_, err := zmsClient.PutMembership(zms.DomainName(domain), zms.EntityName(roleName), memberName, auditRef, &retObject, c.ResourceOwner, membership)
 		return err
-	}
-	return fmt.Errorf("too many requests, retried 3 times but still failed: %w", err)
+	})
 }
 
-func (c Client) PutMembership(domain string, roleName string, memberName zms.MemberName, auditRef string, membership *zms.Membership) error {
-	var err error
+func (c Client) DeleteMembership(ctx context.Context, domain string, roleMember string, member zms.MemberName, auditRef string) error {
+	return c.retry(ctx, "DeleteMembership", false, func(zmsClient zms.ZMSClient) error {
+		return zmsClient.DeleteMembership(zms.DomainName(domain), zms.EntityName(roleMember), member, auditRef, c.ResourceOwner)
+	})
+}
+
+func (c Client) PutGroupMeta(ctx context.Context, domain string, groupName string, auditRef string, groupMeta *zms.GroupMeta) error {
+	return c.retry(ctx, "PutGroupMeta", false, func(zmsClient zms.ZMSClient) error {
+		return zmsClient.PutGroupMeta(zms.DomainName(domain), zms.EntityName(groupName), auditRef, c.ResourceOwner, groupMeta)
+	})
+}
+
+func (c Client) PutRoleMeta(ctx context.Context, domain string, roleName string, auditRef string, roleMeta *zms.RoleMeta) error {
+	return c.retry(ctx, "PutRoleMeta", false, func(zmsClient zms.ZMSClient) error {
+		return zmsClient.PutRoleMeta(zms.DomainName(domain), zms.EntityName(roleName), auditRef, c.ResourceOwner, roleMeta)
+	})
+}
+
+func (c Client) GetDomainTemplateDetailsList(ctx context.Context, domainName string) (*zms.DomainTemplateDetailsList, error) {
+	var templates *zms.DomainTemplateDetailsList
+	err := c.retry(ctx, "GetDomainTemplateDetailsList", true, func(zmsClient zms.ZMSClient) (err error) {
+		templates, err = zmsClient.GetDomainTemplateDetailsList(zms.DomainName(domainName))
+		return err
+	})
+	return templates, err
+}
+
+func (c Client) GetServerTemplateDetailsList(ctx context.Context) (*zms.DomainTemplateDetailsList, error) {
+	var templates *zms.DomainTemplateDetailsList
+	err := c.retry(ctx, "GetServerTemplateDetailsList", true, func(zmsClient zms.ZMSClient) (err error) {
+		templates, err = zmsClient.GetServerTemplateDetailsList()
+		return err
+	})
+	return templates, err
+}
+
+func (c Client) PutDomainTemplate(ctx context.Context, domainName string, templateName string, auditRef string, template *zms.DomainTemplate) error {
+	return c.retry(ctx, "PutDomainTemplate", false, func(zmsClient zms.ZMSClient) error {
+		return zmsClient.PutDomainTemplateExt(zms.DomainName(domainName), zms.SimpleName(templateName), auditRef, template)
+	})
+}
+
+func (c Client) DeleteDomainTemplate(ctx context.Context, domainName string, templateName string, auditRef string) error {
+	return c.retry(ctx, "DeleteDomainTemplate", false, func(zmsClient zms.ZMSClient) error {
+		return zmsClient.DeleteDomainTemplate(zms.DomainName(domainName), zms.SimpleName(templateName), auditRef)
+	})
+}
+
+func (c Client) GetTemplate(ctx context.Context, templateName string) (*zms.Template, error) {
+	var template *zms.Template
+	err := c.retry(ctx, "GetTemplate", true, func(zmsClient zms.ZMSClient) (err error) {
+		template, err = zmsClient.GetTemplate(zms.SimpleName(templateName))
+		return err
+	})
+	return template, err
+}
+
+func (c Client) GetEntity(ctx context.Context, domainName string, entityName string) (*zms.Entity, error) {
+	var entity *zms.Entity
+	err := c.retry(ctx, "GetEntity", true, func(zmsClient zms.ZMSClient) (err error) {
+		entity, err = zmsClient.GetEntity(zms.DomainName(domainName), zms.EntityName(entityName))
+		return err
+	})
+	return entity, err
+}
+
+func (c Client) GetEntityList(ctx context.Context, domainName string) (*zms.EntityList, error) {
+	var entities *zms.EntityList
+	err := c.retry(ctx, "GetEntityList", true, func(zmsClient zms.ZMSClient) (err error) {
+		entities, err = zmsClient.GetEntityList(zms.DomainName(domainName))
+		return err
+	})
+	return entities, err
+}
+
+func (c Client) PutEntity(ctx context.Context, domainName string, entityName string, auditRef string, entity *zms.Entity) error {
+	return c.retry(ctx, "PutEntity", false, func(zmsClient zms.ZMSClient) error {
+		return zmsClient.PutEntity(zms.DomainName(domainName), zms.EntityName(entityName), auditRef, entity)
+	})
+}
+
+func (c Client) DeleteEntity(ctx context.Context, domainName string, entityName string, auditRef string) error {
+	return c.retry(ctx, "DeleteEntity", false, func(zmsClient zms.ZMSClient) error {
+		return zmsClient.DeleteEntity(zms.DomainName(domainName), zms.EntityName(entityName), auditRef)
+	})
+}
+
+func (c Client) GetQuota(ctx context.Context, domainName string) (*zms.Quota, error) {
+	var quota *zms.Quota
+	err := c.retry(ctx, "GetQuota", true, func(zmsClient zms.ZMSClient) (err error) {
+		quota, err = zmsClient.GetQuota(zms.DomainName(domainName))
+		return err
+	})
+	return quota, err
+}
+
+func (c Client) PutQuota(ctx context.Context, domainName string, auditRef string, quota *zms.Quota) error {
+	return c.retry(ctx, "PutQuota", false, func(zmsClient zms.ZMSClient) error {
+		return zmsClient.PutQuota(zms.DomainName(domainName), auditRef, quota)
+	})
+}
+
+func (c Client) DeleteQuota(ctx context.Context, domainName string, auditRef string) error {
+	return c.retry(ctx, "DeleteQuota", false, func(zmsClient zms.ZMSClient) error {
+		return zmsClient.DeleteQuota(zms.DomainName(domainName), auditRef)
+	})
+}
+
+func (c Client) GetMembership(ctx context.Context, domain string, roleName string, memberName zms.MemberName) (*zms.Membership, error) {
+	var membership *zms.Membership
+	err := c.retry(ctx, "GetMembership", true, func(zmsClient zms.ZMSClient) (err error) {
+		membership, err = zmsClient.GetMembership(zms.DomainName(domain), zms.EntityName(roleName), memberName, "")
+		return err
+	})
+	return membership, err
+}
+
+func (c Client) DeletePendingMembership(ctx context.Context, domain string, roleName string, memberName zms.MemberName, auditRef string) error {
+	return c.retry(ctx, "DeletePendingMembership", false, func(zmsClient zms.ZMSClient) error {
+		return zmsClient.DeletePendingMembership(zms.DomainName(domain), zms.EntityName(roleName), memberName, auditRef)
+	})
+}
+
+func (c Client) GetGroupMembership(ctx context.Context, domain string, groupName string, memberName zms.GroupMemberName) (*zms.GroupMembership, error) {
+	var membership *zms.GroupMembership
+	err := c.retry(ctx, "GetGroupMembership", true, func(zmsClient zms.ZMSClient) (err error) {
+		membership, err = zmsClient.GetGroupMembership(zms.DomainName(domain), zms.EntityName(groupName), memberName, "")
+		return err
+	})
+	return membership, err
+}
+
+func (c Client) DeletePendingGroupMembership(ctx context.Context, domain string, groupName string, memberName zms.GroupMemberName, auditRef string) error {
+	return c.retry(ctx, "DeletePendingGroupMembership", false, func(zmsClient zms.ZMSClient) error {
+		return zmsClient.DeletePendingGroupMembership(zms.DomainName(domain), zms.EntityName(groupName), memberName, auditRef)
+	})
+}
+
+func (c Client) GetRoleWithPendingMembers(ctx context.Context, domain string, roleName string) (*zms.Role, error) {
+	var role *zms.Role
+	pending := true
+	err := c.retry(ctx, "GetRoleWithPendingMembers", true, func(zmsClient zms.ZMSClient) (err error) {
+		role, err = zmsClient.GetRole(zms.DomainName(domain), zms.EntityName(roleName), nil, nil, &pending)
+		return err
+	})
+	return role, err
+}
+
+func (c Client) GetGroupWithPendingMembers(ctx context.Context, domain string, groupName string) (*zms.Group, error) {
+	var group *zms.Group
+	pending := true
+	err := c.retry(ctx, "GetGroupWithPendingMembers", true, func(zmsClient zms.ZMSClient) (err error) {
+		group, err = zmsClient.GetGroup(zms.DomainName(domain), zms.EntityName(groupName), nil, &pending)
+		return err
+	})
+	return group, err
+}
+
+func (c Client) PutMembershipDecision(ctx context.Context, domain string, roleName string, memberName zms.MemberName, auditRef string, membership *zms.Membership) error {
+	return c.retry(ctx, "PutMembershipDecision", false, func(zmsClient zms.ZMSClient) error {
+		return zmsClient.PutMembershipDecision(zms.DomainName(domain), zms.EntityName(roleName), memberName, auditRef, membership)
+	})
+}
+
+func (c Client) PutRoleReview(ctx context.Context, domain string, roleName string, auditRef string, role *zms.Role) error {
 	retObject := false
-	zmsClient := zms.NewClient(c.Url, c.Transport)
-	for _, delay := range append([]time.Duration{0}, retryDelays...) {
-		if delay > 0 {
-			time.Sleep(delay)
-		}
-		_, err = zmsClient.PutMembership(zms.DomainName(domain), zms.EntityName(roleName), memberName, auditRef, &retObject, c.ResourceOwner, membership)
-		if errObj, ok := err.(rdl.ResourceError); ok && errObj.Code == ErrCodeRateLimit {
-			continue
-		}
+	return c.retry(ctx, "PutRoleReview", false, func(zmsClient zms.ZMSClient) error {
+		_, err := zmsClient.PutRoleReview(zms.DomainName(domain), zms.EntityName(roleName), auditRef, &retObject, c.ResourceOwner, role)
 		return err
-	}
-	return fmt.Errorf("too many requests, retried 3 times but still failed: %w", err)
+	})
 }
 
-func (c Client) DeleteMembership(domain string, roleMember string, member zms.MemberName, auditRef string) error {
-	var err error
-	zmsClient := zms.NewClient(c.Url, c.Transport)
-	for _, delay := range append([]time.Duration{0}, retryDelays...) {
-		if delay > 0 {
-			time.Sleep(delay)
-		}
-		err = zmsClient.DeleteMembership(zms.DomainName(domain), zms.EntityName(roleMember), member, auditRef, c.ResourceOwner)
-		if errObj, ok := err.(rdl.ResourceError); ok && errObj.Code == ErrCodeRateLimit {
-			continue
-		}
+func (c Client) GetServiceIdentities(ctx context.Context, domain string, publicKeys bool, hosts bool, tagKey string, tagValue string) (*zms.ServiceIdentities, error) {
+	var serviceIdentities *zms.ServiceIdentities
+	err := c.retry(ctx, "GetServiceIdentities", true, func(zmsClient zms.ZMSClient) (err error) {
+		serviceIdentities, err = zmsClient.GetServiceIdentities(zms.DomainName(domain), &publicKeys, &hosts, zms.TagKey(tagKey), zms.TagCompoundValue(tagValue))
 		return err
-	}
-	return fmt.Errorf("too many requests, retried 3 times but still failed: %w", err)
+	})
+	return serviceIdentities, err
 }
 
-func (c Client) PutGroupMeta(domain string, groupName string, auditRef string, groupMeta *zms.GroupMeta) error {
-	var err error
-	zmsClient := zms.NewClient(c.Url, c.Transport)
-	for _, delay := range append([]time.Duration{0}, retryDelays...) {
-		if delay > 0 {
-			time.Sleep(delay)
-		}
-		err = zmsClient.PutGroupMeta(zms.DomainName(domain), zms.EntityName(groupName), auditRef, c.ResourceOwner, groupMeta)
-		if errObj, ok := err.(rdl.ResourceError); ok && errObj.Code == ErrCodeRateLimit {
-			continue
-		}
+func (c Client) GetPublicKeyEntry(ctx context.Context, domain string, serviceName string, keyId string) (*zms.PublicKeyEntry, error) {
+	var publicKeyEntry *zms.PublicKeyEntry
+	err := c.retry(ctx, "GetPublicKeyEntry", true, func(zmsClient zms.ZMSClient) (err error) {
+		publicKeyEntry, err = zmsClient.GetPublicKeyEntry(zms.DomainName(domain), zms.SimpleName(serviceName), keyId)
 		return err
-	}
-	return fmt.Errorf("too many requests, retried 3 times but still failed: %w", err)
+	})
+	return publicKeyEntry, err
 }
 
-func (c Client) PutRoleMeta(domain string, roleName string, auditRef string, roleMeta *zms.RoleMeta) error {
-	var err error
-	zmsClient := zms.NewClient(c.Url, c.Transport)
-	for _, delay := range append([]time.Duration{0}, retryDelays...) {
-		if delay > 0 {
-			time.Sleep(delay)
-		}
-		err = zmsClient.PutRoleMeta(zms.DomainName(domain), zms.EntityName(roleName), auditRef, c.ResourceOwner, roleMeta)
-		if errObj, ok := err.(rdl.ResourceError); ok && errObj.Code == ErrCodeRateLimit {
-			continue
-		}
+func (c Client) PutPublicKeyEntry(ctx context.Context, domain string, serviceName string, keyId string, auditRef string, publicKeyEntry *zms.PublicKeyEntry) error {
+	return c.retry(ctx, "PutPublicKeyEntry", false, func(zmsClient zms.ZMSClient) error {
+		return zmsClient.PutPublicKeyEntry(zms.DomainName(domain), zms.SimpleName(serviceName), keyId, auditRef, c.ResourceOwner, publicKeyEntry)
+	})
+}
+
+func (c Client) DeletePublicKeyEntry(ctx context.Context, domain string, serviceName string, keyId string, auditRef string) error {
+	return c.retry(ctx, "DeletePublicKeyEntry", false, func(zmsClient zms.ZMSClient) error {
+		return zmsClient.DeletePublicKeyEntry(zms.DomainName(domain), zms.SimpleName(serviceName), keyId, auditRef, c.ResourceOwner)
+	})
+}
+
+func (c Client) PutServiceIdentitySystemMeta(ctx context.Context, domain string, serviceName string, attribute string, auditRef string, meta *zms.ServiceIdentitySystemMeta) error {
+	return c.retry(ctx, "PutServiceIdentitySystemMeta", false, func(zmsClient zms.ZMSClient) error {
+		return zmsClient.PutServiceIdentitySystemMeta(zms.DomainName(domain), zms.SimpleName(serviceName), zms.SimpleName(attribute), auditRef, meta)
+	})
+}
+
+func (c Client) PutServiceCredsEntry(ctx context.Context, domain string, serviceName string, auditRef string, creds *zms.CredsEntry) error {
+	return c.retry(ctx, "PutServiceCredsEntry", false, func(zmsClient zms.ZMSClient) error {
+		return zmsClient.PutServiceCredsEntry(zms.DomainName(domain), zms.SimpleName(serviceName), auditRef, c.ResourceOwner, creds)
+	})
+}
+
+func (c Client) PutTenancy(ctx context.Context, domain string, providerService string, auditRef string, tenancy *zms.Tenancy) error {
+	return c.retry(ctx, "PutTenancy", false, func(zmsClient zms.ZMSClient) error {
+		return zmsClient.PutTenancy(zms.DomainName(domain), zms.ServiceName(providerService), auditRef, tenancy)
+	})
+}
+
+func (c Client) DeleteTenancy(ctx context.Context, domain string, providerService string, auditRef string) error {
+	return c.retry(ctx, "DeleteTenancy", false, func(zmsClient zms.ZMSClient) error {
+		return zmsClient.DeleteTenancy(zms.DomainName(domain), zms.ServiceName(providerService), auditRef)
+	})
+}
+
+func (c Client) GetProviderResourceGroupRoles(ctx context.Context, domain string, providerDomain string, providerService string, resourceGroup string) (*zms.ProviderResourceGroupRoles, error) {
+	var roles *zms.ProviderResourceGroupRoles
+	err := c.retry(ctx, "GetProviderResourceGroupRoles", true, func(zmsClient zms.ZMSClient) (err error) {
+		roles, err = zmsClient.GetProviderResourceGroupRoles(zms.DomainName(domain), zms.DomainName(providerDomain), zms.SimpleName(providerService), zms.EntityName(resourceGroup))
+		return err
+	})
+	return roles, err
+}
+
+func (c Client) PutProviderResourceGroupRoles(ctx context.Context, domain string, providerDomain string, providerService string, resourceGroup string, auditRef string, roles *zms.ProviderResourceGroupRoles) error {
+	return c.retry(ctx, "PutProviderResourceGroupRoles", false, func(zmsClient zms.ZMSClient) error {
+		_, err := zmsClient.PutProviderResourceGroupRoles(zms.DomainName(domain), zms.DomainName(providerDomain), zms.SimpleName(providerService), zms.EntityName(resourceGroup), auditRef, roles)
+		return err
+	})
+}
+
+func (c Client) DeleteProviderResourceGroupRoles(ctx context.Context, domain string, providerDomain string, providerService string, resourceGroup string, auditRef string) error {
+	return c.retry(ctx, "DeleteProviderResourceGroupRoles", false, func(zmsClient zms.ZMSClient) error {
+		return zmsClient.DeleteProviderResourceGroupRoles(zms.DomainName(domain), zms.DomainName(providerDomain), zms.SimpleName(providerService), zms.EntityName(resourceGroup), auditRef)
+	})
+}
+
+func (c Client) PutDomainDependency(ctx context.Context, domain string, service string, auditRef string) error {
+	return c.retry(ctx, "PutDomainDependency", false, func(zmsClient zms.ZMSClient) error {
+		return zmsClient.PutDomainDependency(zms.DomainName(domain), auditRef, &zms.DependentService{Service: zms.ServiceName(service)})
+	})
+}
+
+func (c Client) DeleteDomainDependency(ctx context.Context, domain string, service string, auditRef string) error {
+	return c.retry(ctx, "DeleteDomainDependency", false, func(zmsClient zms.ZMSClient) error {
+		return zmsClient.DeleteDomainDependency(zms.DomainName(domain), zms.ServiceName(service), auditRef)
+	})
+}
+
+func (c Client) GetDependentServiceList(ctx context.Context, domain string) (*zms.ServiceIdentityList, error) {
+	var services *zms.ServiceIdentityList
+	err := c.retry(ctx, "GetDependentServiceList", true, func(zmsClient zms.ZMSClient) (err error) {
+		services, err = zmsClient.GetDependentServiceList(zms.DomainName(domain))
+		return err
+	})
+	return services, err
+}
+
+func (c Client) GetDependentDomainList(ctx context.Context, service string) (*zms.DomainList, error) {
+	var domains *zms.DomainList
+	err := c.retry(ctx, "GetDependentDomainList", true, func(zmsClient zms.ZMSClient) (err error) {
+		domains, err = zmsClient.GetDependentDomainList(zms.ServiceName(service))
+		return err
+	})
+	return domains, err
+}
+
+func (c Client) GetDomainList(ctx context.Context, filter DomainListFilter, skip string) (*zms.DomainList, error) {
+	var domainList *zms.DomainList
+	err := c.retry(ctx, "GetDomainList", true, func(zmsClient zms.ZMSClient) (err error) {
+		domainList, err = zmsClient.GetDomainList(nil, skip, filter.Prefix, filter.Depth, filter.Account, nil, zms.ResourceName(filter.RoleMember), zms.ResourceName(filter.RoleName), "", "", zms.TagKey(filter.TagKey), zms.TagCompoundValue(filter.TagValue), filter.BusinessService, filter.ProductId, filter.ModifiedSince)
+		return err
+	})
+	return domainList, err
+}
+
+func (c Client) GetPrincipalRoles(ctx context.Context, principal string, domain string, expand bool) (*zms.DomainRoleMember, error) {
+	var roles *zms.DomainRoleMember
+	err := c.retry(ctx, "GetPrincipalRoles", true, func(zmsClient zms.ZMSClient) (err error) {
+		roles, err = zmsClient.GetPrincipalRoles(zms.PrincipalName(principal), zms.DomainName(domain), &expand)
 		return err
+	})
+	return roles, err
+}
+
+func (c Client) GetPrincipalGroups(ctx context.Context, principal string, domain string) (*zms.DomainGroupMember, error) {
+	var groups *zms.DomainGroupMember
+	err := c.retry(ctx, "GetPrincipalGroups", true, func(zmsClient zms.ZMSClient) (err error) {
+		groups, err = zmsClient.GetPrincipalGroups(zms.PrincipalName(principal), zms.DomainName(domain))
+		return err
+	})
+	return groups, err
+}
+
+func (c Client) GetHostServices(ctx context.Context, host string) (*HostServices, error) {
+	var hostServices *HostServices
+	err := c.retry(ctx, "GetHostServices", true, func(zmsClient zms.ZMSClient) (err error) {
+		hostServices, err = getHostServices(zmsClient, host)
+		return err
+	})
+	return hostServices, err
+}
+
+// getHostServices sends GET /host/{host}/services the way the generated zms
+// client methods do, the pinned zms client has no wrapper for this API.
+func getHostServices(zmsClient zms.ZMSClient, host string) (*HostServices, error) {
+	var data *HostServices
+	httpClient := &http.Client{Transport: zmsClient.Transport, Timeout: zmsClient.Timeout}
+	req, err := http.NewRequest(http.MethodGet, zmsClient.URL+"/host/"+url.PathEscape(host)+"/services", nil)
+	if err != nil {
+		return data, err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return data, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusOK {
+		err = json.NewDecoder(resp.Body).Decode(&data)
+		return data, err
 	}
-	return fmt.Errorf("too many requests, retried 3 times but still failed: %w", err)
+	var errobj rdl.ResourceError
+	contentBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return data, err
+	}
+	_ = json.Unmarshal(contentBytes, &errobj)
+	if errobj.Code == 0 {
+		errobj.Code = resp.StatusCode
+	}
+	if errobj.Message == "" {
+		errobj.Message = string(contentBytes)
+	}
+	return data, errobj
 }
 
 func (c Client) GetRoleMetaResourceState(roleMetaResourceState, requestedState int) bool {
@@ -807,26 +831,143 @@ func getResourceState(resourceState, clientState, requestedState int) bool {
 	return (resourceState & requestedState) != 0
 }
 
+// sleep waits for the given delay, returning early with the context error
+// if ctx is cancelled or its deadline expires first.
+func sleep(ctx context.Context, delay time.Duration) error {
+	if delay <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
 func NewClient(zmsConfig *ZmsConfig) (*Client, error) {
-	tlsConfig, err := getTLSConfigFromFiles(zmsConfig.Cert, zmsConfig.Key, zmsConfig.CaCert)
+	var (
+		tlsConfig *tls.Config
+		certs     *certReloader
+		tokens    tokenSource
+		err       error
+	)
+	switch zmsConfig.AuthMode {
+	case "", AuthModeMTLS:
+		tlsConfig, certs, err = newTLSConfig(zmsConfig)
+	case AuthModeToken:
+		if tokens, err = newTokenSource(zmsConfig); err == nil {
+			tlsConfig, err = newServerTLSConfig(zmsConfig)
+		}
+	default:
+		err = fmt.Errorf("unsupported auth mode: %s", zmsConfig.AuthMode)
+	}
 	if err != nil {
 		return nil, err
 	}
-	transport := http.Transport{
-		TLSClientConfig: tlsConfig,
+	transport := newTransport(tlsConfig, zmsConfig)
+	var roundTripper http.RoundTripper = transport
+	if tokens != nil {
+		roundTripper = &bearerTransport{source: tokens, transport: transport}
+	}
+	if zmsConfig.UserAgent != "" || len(zmsConfig.Headers) > 0 {
+		roundTripper = &headerTransport{userAgent: zmsConfig.UserAgent, headers: zmsConfig.Headers, transport: roundTripper}
 	}
+	urls := zmsConfig.Urls
+	if len(urls) == 0 {
+		urls = []string{zmsConfig.Url}
+	}
+	zmsClient := zms.NewClient(urls[0], roundTripper)
+	zmsClient.Timeout = zmsConfig.RequestTimeout
 	client := &Client{
-		Url:                    zmsConfig.Url,
-		Transport:              &transport,
+		Url:                    urls[0],
+		Transport:              transport,
 		ResourceOwner:          zmsConfig.ResourceOwner,
 		RoleMetaResourceState:  zmsConfig.RoleMetaResourceState,
 		GroupMetaResourceState: zmsConfig.GroupMetaResourceState,
+		retryPolicy:            newRetryPolicy(zmsConfig.MaxRetries, zmsConfig.RetryMaxWait, zmsConfig.RetryOnStatus),
+		limiter:                newRequestLimiter(zmsConfig.RequestsPerSecond, zmsConfig.MaxConcurrentRequests),
+		zmsClient:              zmsClient,
+		certs:                  certs,
+		endpoints:              newEndpointPool(urls),
 	}
 	return client, err
 }
 
-func getTLSConfigFromFiles(certFile, keyFile string, caCert string) (*tls.Config, error) {
-	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+// newTLSConfig builds the client TLS configuration. Inline PEM material takes
+// precedence over the certificate, key and CA files; only certificates read
+// from files can be reloaded when rotated.
+func newTLSConfig(zmsConfig *ZmsConfig) (*tls.Config, *certReloader, error) {
+	if zmsConfig.CertPem != "" || zmsConfig.KeyPem != "" {
+		config, err := getTLSConfigFromBytes([]byte(zmsConfig.CertPem), []byte(zmsConfig.KeyPem), []byte(zmsConfig.CaCertPem))
+		if err != nil {
+			return nil, nil, err
+		}
+		if zmsConfig.CaCertPem == "" && zmsConfig.CaCert != "" {
+			if config.RootCAs, err = getCertPoolFromFile(zmsConfig.CaCert); err != nil {
+				return nil, nil, err
+			}
+		}
+		return config, nil, nil
+	}
+	caCert := zmsConfig.CaCert
+	if zmsConfig.CaCertPem != "" {
+		caCert = ""
+	}
+	config, certs, err := getTLSConfigFromFiles(zmsConfig.Cert, zmsConfig.Key, caCert, zmsConfig.CertReloadInterval)
+	if err != nil {
+		return nil, nil, err
+	}
+	if zmsConfig.CaCertPem != "" {
+		if config.RootCAs, err = getCertPoolFromBytes([]byte(zmsConfig.CaCertPem)); err != nil {
+			return nil, nil, err
+		}
+	}
+	return config, certs, nil
+}
+
+// newServerTLSConfig builds a TLS configuration without a client certificate,
+// used when the client authenticates with an access token instead.
+func newServerTLSConfig(zmsConfig *ZmsConfig) (*tls.Config, error) {
+	config := &tls.Config{}
+	var err error
+	switch {
+	case zmsConfig.CaCertPem != "":
+		config.RootCAs, err = getCertPoolFromBytes([]byte(zmsConfig.CaCertPem))
+	case zmsConfig.CaCert != "":
+		config.RootCAs, err = getCertPoolFromFile(zmsConfig.CaCert)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+func getTLSConfigFromFiles(certFile, keyFile string, caCert string, reloadInterval time.Duration) (*tls.Config, *certReloader, error) {
+	certs, err := newCertReloader(certFile, keyFile, reloadInterval)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	config := &tls.Config{}
+	config.GetClientCertificate = certs.GetClientCertificate
+
+	if caCert != "" {
+		if config.RootCAs, err = getCertPoolFromFile(caCert); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// Set Renegotiation explicitly
+	config.Renegotiation = tls.RenegotiateOnceAsClient
+
+	return config, certs, err
+}
+
+func getTLSConfigFromBytes(certPem, keyPem, caCertPem []byte) (*tls.Config, error) {
+	cert, err := tls.X509KeyPair(certPem, keyPem)
 	if err != nil {
 		return nil, fmt.Errorf("unable to formulate clientCert from key and cert bytes, error: %v", err)
 	}
@@ -835,18 +976,32 @@ func getTLSConfigFromFiles(certFile, keyFile string, caCert string) (*tls.Config
 	config.Certificates = make([]tls.Certificate, 1)
 	config.Certificates[0] = cert
 
-	if caCert != "" {
-		caCertPem, err := os.ReadFile(caCert)
-		if err != nil {
-			return nil, fmt.Errorf("unable to cacert file, error: %v", err)
+	if len(caCertPem) != 0 {
+		if config.RootCAs, err = getCertPoolFromBytes(caCertPem); err != nil {
+			return nil, err
 		}
-		certPool := x509.NewCertPool()
-		certPool.AppendCertsFromPEM(caCertPem)
-		config.RootCAs = certPool
 	}
 
 	// Set Renegotiation explicitly
 	config.Renegotiation = tls.RenegotiateOnceAsClient
 
-	return config, err
+	return config, nil
+}
+
+func getCertPoolFromFile(caCert string) (*x509.CertPool, error) {
+	caCertPem, err := os.ReadFile(caCert)
+	if err != nil {
+		return nil, fmt.Errorf("unable to cacert file, error: %v", err)
+	}
+	certPool := x509.NewCertPool()
+	certPool.AppendCertsFromPEM(caCertPem)
+	return certPool, nil
+}
+
+func getCertPoolFromBytes(caCertPem []byte) (*x509.CertPool, error) {
+	certPool := x509.NewCertPool()
+	if !certPool.AppendCertsFromPEM(caCertPem) {
+		return nil, fmt.Errorf("unable to parse cacert pem, no certificates found")
+	}
+	return certPool, nil
 }
@@ -1,7 +1,15 @@
 package client
 
 import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
 	"testing"
+	"time"
+
+	"github.com/ardielle/ardielle-go/rdl"
 )
 
 func TestGetResourceState(t *testing.T) {
@@ -71,3 +79,96 @@ func TestGetResourceState(t *testing.T) {
 		})
 	}
 }
+
+func TestSleepHonoursContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	start := time.Now()
+	if err := sleep(ctx, time.Minute); !errors.Is(err, context.Canceled) {
+		t.Fatalf("sleep() error = %v, want %v", err, context.Canceled)
+	}
+	if time.Since(start) > time.Second {
+		t.Fatalf("sleep() did not return when the context was cancelled")
+	}
+	if err := sleep(context.Background(), time.Millisecond); err != nil {
+		t.Fatalf("sleep() error = %v, want nil", err)
+	}
+}
+
+func TestCancelStopsRateLimitRetries(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(ErrCodeRateLimit)
+		_, _ = w.Write([]byte(`{"code":429,"message":"too many requests"}`))
+	}))
+	defer server.Close()
+
+	c := Client{Url: server.URL, Transport: &http.Transport{}}
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := c.GetRole(ctx, "sys.auth", "admin")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("GetRole() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Fatalf("GetRole() kept retrying for %s after the deadline", elapsed)
+	}
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Fatalf("server received %d calls, want 1", got)
+	}
+}
+
+func TestCancelAbortsInFlightRequest(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	c := Client{Url: server.URL, Transport: &http.Transport{}}
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(100*time.Millisecond, cancel)
+
+	start := time.Now()
+	_, err := c.GetDomain(ctx, "sys.auth")
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("GetDomain() error = %v, want %v", err, context.Canceled)
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Fatalf("GetDomain() returned %s after cancellation", elapsed)
+	}
+}
+
+func TestGetHostServices(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/host/host1.example.com/services" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"code":404,"message":"not found"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"host":"host1.example.com","names":["some_domain.api","other_domain.backend"]}`))
+	}))
+	defer server.Close()
+
+	c := Client{Url: server.URL, Transport: &http.Transport{}}
+	hostServices, err := c.GetHostServices(context.Background(), "host1.example.com")
+	if err != nil {
+		t.Fatalf("GetHostServices() error = %v, want nil", err)
+	}
+	if hostServices.Host != "host1.example.com" || len(hostServices.Names) != 2 || hostServices.Names[1] != "other_domain.backend" {
+		t.Fatalf("GetHostServices() = %+v", hostServices)
+	}
+
+	_, err = c.GetHostServices(context.Background(), "unknown/host")
+	var resourceError rdl.ResourceError
+	if !errors.As(err, &resourceError) || resourceError.Code != http.StatusNotFound {
+		t.Fatalf("GetHostServices() error = %v, want a 404 resource error", err)
+	}
+}
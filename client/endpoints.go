@@ -0,0 +1,129 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/ardielle/ardielle-go/rdl"
+)
+
+// endpointCooldown is how long a ZMS endpoint that failed is skipped in
+// favour of the next one in the list.
+const endpointCooldown = 30 * time.Second
+
+// endpointPool tracks the health of the configured ZMS endpoints. Requests go
+// to the first healthy endpoint in configuration order, so traffic returns to
+// the primary as soon as its cooldown is over and a request to it succeeds.
+type endpointPool struct {
+	urls []string
+
+	mu             sync.Mutex
+	unhealthyUntil map[string]time.Time
+}
+
+func newEndpointPool(urls []string) *endpointPool {
+	return &endpointPool{
+		urls:           urls,
+		unhealthyUntil: make(map[string]time.Time, len(urls)),
+	}
+}
+
+// pick returns the endpoint for the next request, skipping the ones already
+// tried for it. Healthy endpoints are preferred in configuration order; if
+// all of them are cooling down, the one that recovers first is used. It
+// returns "" once every endpoint has been tried.
+func (p *endpointPool) pick(now time.Time, tried map[string]bool) string {
+	if p == nil {
+		return ""
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var fallback string
+	var fallbackUntil time.Time
+	for _, url := range p.urls {
+		if tried[url] {
+			continue
+		}
+		until := p.unhealthyUntil[url]
+		if !now.Before(until) {
+			return url
+		}
+		if fallback == "" || until.Before(fallbackUntil) {
+			fallback, fallbackUntil = url, until
+		}
+	}
+	return fallback
+}
+
+// report records the outcome of a request sent to url.
+func (p *endpointPool) report(url string, err error, now time.Time) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if isEndpointFailure(err) {
+		p.unhealthyUntil[url] = now.Add(endpointCooldown)
+	} else {
+		delete(p.unhealthyUntil, url)
+	}
+}
+
+func (p *endpointPool) size() int {
+	if p == nil {
+		return 1
+	}
+	return len(p.urls)
+}
+
+// annotate adds the endpoint to ZMS errors when several endpoints are
+// configured, so that diagnostics show which one rejected the request. The
+// error keeps its type, callers still inspect the status code.
+func (p *endpointPool) annotate(err error, url string) error {
+	if p.size() < 2 {
+		return err
+	}
+	if errObj, ok := err.(rdl.ResourceError); ok {
+		errObj.Message = fmt.Sprintf("%s (zms endpoint %s)", errObj.Message, url)
+		return errObj
+	}
+	return err
+}
+
+// isEndpointFailure reports whether err means the endpoint itself is in
+// trouble, as opposed to the request being rejected. Request timeouts count
+// as failures, cancellation by Terraform does not.
+func isEndpointFailure(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return false
+	}
+	if errObj, ok := err.(rdl.ResourceError); ok {
+		return errObj.Code >= 500
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) || isConnectionReset(err)
+}
+
+// canFailover reports whether a request that failed with err may be sent to
+// another endpoint. Reads fail over on any endpoint failure. Writes only fail
+// over when the connection could not be established, otherwise the first
+// endpoint may already have applied the change.
+func canFailover(err error, idempotent bool) bool {
+	if !isEndpointFailure(err) {
+		return false
+	}
+	return idempotent || isDialError(err)
+}
+
+func isDialError(err error) bool {
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+	var dnsErr *net.DNSError
+	return errors.As(err, &dnsErr)
+}
@@ -0,0 +1,168 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/AthenZ/athenz/clients/go/zms"
+	"github.com/ardielle/ardielle-go/rdl"
+)
+
+// newEndpointTestServer starts a ZMS stand-in answering with the given status
+// and counting the requests it receives.
+func newEndpointTestServer(t *testing.T, status int, name string) (*httptest.Server, *int32) {
+	t.Helper()
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(status)
+		if status == http.StatusOK {
+			_, _ = w.Write([]byte(`{"name":"` + name + `"}`))
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"code":%d,"message":"unavailable"}`, status)
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func TestEndpointPoolPick(t *testing.T) {
+	now := time.Now()
+	pool := newEndpointPool([]string{"https://zms1", "https://zms2", "https://zms3"})
+	if got := pool.pick(now, nil); got != "https://zms1" {
+		t.Fatalf("pick() = %s, want the primary", got)
+	}
+	pool.report("https://zms1", rdl.ResourceError{Code: 503}, now)
+	if got := pool.pick(now, nil); got != "https://zms2" {
+		t.Fatalf("pick() = %s, want zms2 while the primary cools down", got)
+	}
+	if got := pool.pick(now, map[string]bool{"https://zms2": true}); got != "https://zms3" {
+		t.Fatalf("pick() = %s, want zms3 after zms2 was tried", got)
+	}
+	pool.report("https://zms2", rdl.ResourceError{Code: 503}, now.Add(time.Second))
+	pool.report("https://zms3", rdl.ResourceError{Code: 503}, now.Add(2*time.Second))
+	if got := pool.pick(now, nil); got != "https://zms1" {
+		t.Fatalf("pick() = %s, want the endpoint recovering first", got)
+	}
+	if got := pool.pick(now.Add(endpointCooldown), nil); got != "https://zms1" {
+		t.Fatalf("pick() = %s, want the primary after its cooldown", got)
+	}
+	pool.report("https://zms2", rdl.ResourceError{Code: 404}, now)
+	if got := pool.pick(now, map[string]bool{"https://zms1": true}); got != "https://zms2" {
+		t.Fatalf("pick() = %s, want zms2 after a successful request", got)
+	}
+}
+
+func TestCanFailover(t *testing.T) {
+	dialErr := &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+	readErr := &net.OpError{Op: "read", Net: "tcp", Err: errors.New("connection reset by peer")}
+	tests := []struct {
+		name       string
+		err        error
+		idempotent bool
+		want       bool
+	}{
+		{name: "read on 503", err: rdl.ResourceError{Code: 503}, idempotent: true, want: true},
+		{name: "write on 503", err: rdl.ResourceError{Code: 503}, idempotent: false, want: false},
+		{name: "read on 404", err: rdl.ResourceError{Code: 404}, idempotent: true, want: false},
+		{name: "read on dial error", err: dialErr, idempotent: true, want: true},
+		{name: "write on dial error", err: dialErr, idempotent: false, want: true},
+		{name: "read on connection error", err: readErr, idempotent: true, want: true},
+		{name: "write on connection error", err: readErr, idempotent: false, want: false},
+		{name: "canceled", err: context.Canceled, idempotent: true, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := canFailover(tt.err, tt.idempotent); got != tt.want {
+				t.Errorf("canFailover() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClientFailsOverReads(t *testing.T) {
+	primary, primaryRequests := newEndpointTestServer(t, http.StatusServiceUnavailable, "")
+	secondary, _ := newEndpointTestServer(t, http.StatusOK, "secondary")
+	c, err := NewClient(&ZmsConfig{
+		Urls:        []string{primary.URL, secondary.URL},
+		AuthMode:    AuthModeToken,
+		AccessToken: "token",
+		MaxRetries:  0,
+	})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	for i := 0; i < 2; i++ {
+		domain, err := c.GetDomain(context.Background(), "sys.auth")
+		if err != nil {
+			t.Fatalf("GetDomain() error = %v", err)
+		}
+		if domain.Name != "secondary" {
+			t.Fatalf("GetDomain() = %s, want secondary", domain.Name)
+		}
+	}
+	// the primary is skipped while it cools down
+	if got := atomic.LoadInt32(primaryRequests); got != 1 {
+		t.Errorf("primary received %d requests, want 1", got)
+	}
+}
+
+func TestClientDoesNotFailOverWrites(t *testing.T) {
+	primary, _ := newEndpointTestServer(t, http.StatusServiceUnavailable, "")
+	secondary, secondaryRequests := newEndpointTestServer(t, http.StatusOK, "secondary")
+	c, err := NewClient(&ZmsConfig{
+		Urls:        []string{primary.URL, secondary.URL},
+		AuthMode:    AuthModeToken,
+		AccessToken: "token",
+		MaxRetries:  0,
+	})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	err = c.PutRole(context.Background(), "sys.auth", "readers", "", &zms.Role{})
+	if err == nil {
+		t.Fatal("PutRole() succeeded, want the primary error")
+	}
+	if !strings.Contains(err.Error(), primary.URL) {
+		t.Errorf("PutRole() error = %v, want it to name the endpoint", err)
+	}
+	if _, ok := err.(rdl.ResourceError); !ok {
+		t.Errorf("PutRole() error is %T, want rdl.ResourceError", err)
+	}
+	if got := atomic.LoadInt32(secondaryRequests); got != 0 {
+		t.Errorf("secondary received %d requests, want 0", got)
+	}
+}
+
+func TestClientFailsOverWritesOnDialError(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	unreachable := "http://" + listener.Addr().String()
+	listener.Close()
+	secondary, secondaryRequests := newEndpointTestServer(t, http.StatusOK, "secondary")
+	c, err := NewClient(&ZmsConfig{
+		Urls:        []string{unreachable, secondary.URL},
+		AuthMode:    AuthModeToken,
+		AccessToken: "token",
+		MaxRetries:  0,
+	})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	if err := c.PutRole(context.Background(), "sys.auth", "readers", "", &zms.Role{}); err != nil {
+		t.Fatalf("PutRole() error = %v", err)
+	}
+	if got := atomic.LoadInt32(secondaryRequests); got != 1 {
+		t.Errorf("secondary received %d requests, want 1", got)
+	}
+}
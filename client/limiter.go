@@ -0,0 +1,89 @@
+package client
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// requestLimiter throttles the ZMS calls made by one provider instance. It
+// combines a token bucket, which bounds the request rate, with a semaphore
+// that bounds the number of requests in flight. Both limits are shared by
+// all resources since Terraform hands every resource the same client.
+type requestLimiter struct {
+	mu         sync.Mutex
+	rate       float64
+	burst      float64
+	tokens     float64
+	last       time.Time
+	concurrent chan struct{}
+}
+
+// newRequestLimiter returns nil when neither limit is set, so callers can
+// skip throttling entirely for the default configuration.
+func newRequestLimiter(requestsPerSecond float64, maxConcurrent int) *requestLimiter {
+	if requestsPerSecond <= 0 && maxConcurrent <= 0 {
+		return nil
+	}
+	limiter := &requestLimiter{}
+	if requestsPerSecond > 0 {
+		limiter.rate = requestsPerSecond
+		limiter.burst = math.Max(1, math.Floor(requestsPerSecond))
+		limiter.tokens = limiter.burst
+		limiter.last = time.Now()
+	}
+	if maxConcurrent > 0 {
+		limiter.concurrent = make(chan struct{}, maxConcurrent)
+	}
+	return limiter
+}
+
+// acquire blocks until the request may be sent or ctx is done. On success the
+// returned function must be called once the request has completed.
+func (l *requestLimiter) acquire(ctx context.Context) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+	if err := l.waitForToken(ctx); err != nil {
+		return nil, err
+	}
+	if l.concurrent == nil {
+		return func() {}, nil
+	}
+	select {
+	case l.concurrent <- struct{}{}:
+		return func() { <-l.concurrent }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (l *requestLimiter) waitForToken(ctx context.Context) error {
+	if l.rate <= 0 {
+		return nil
+	}
+	for {
+		delay := l.reserve(time.Now())
+		if delay <= 0 {
+			return nil
+		}
+		if err := sleep(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
+
+// reserve takes a token if one is available and returns zero, otherwise it
+// returns how long the caller should wait before trying again.
+func (l *requestLimiter) reserve(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+	if l.tokens >= 1 {
+		l.tokens--
+		return 0
+	}
+	return time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+}
@@ -0,0 +1,93 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestNewRequestLimiterUnlimited(t *testing.T) {
+	if limiter := newRequestLimiter(0, 0); limiter != nil {
+		t.Fatalf("newRequestLimiter(0, 0) = %v, want nil", limiter)
+	}
+	var limiter *requestLimiter
+	release, err := limiter.acquire(context.Background())
+	if err != nil {
+		t.Fatalf("acquire() on nil limiter error = %v", err)
+	}
+	release()
+}
+
+func TestRequestLimiterRate(t *testing.T) {
+	limiter := newRequestLimiter(2, 0)
+	now := limiter.last
+	if delay := limiter.reserve(now); delay != 0 {
+		t.Fatalf("first reserve() = %s, want 0", delay)
+	}
+	if delay := limiter.reserve(now); delay != 0 {
+		t.Fatalf("second reserve() = %s, want 0", delay)
+	}
+	if delay := limiter.reserve(now); delay != 500*time.Millisecond {
+		t.Fatalf("third reserve() = %s, want 500ms", delay)
+	}
+	if delay := limiter.reserve(now.Add(500 * time.Millisecond)); delay != 0 {
+		t.Fatalf("reserve() after refill = %s, want 0", delay)
+	}
+}
+
+func TestRequestLimiterConcurrency(t *testing.T) {
+	limiter := newRequestLimiter(0, 2)
+	var inFlight, maxInFlight int32
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			release, err := limiter.acquire(context.Background())
+			if err != nil {
+				t.Errorf("acquire() error = %v", err)
+				return
+			}
+			current := atomic.AddInt32(&inFlight, 1)
+			for {
+				seen := atomic.LoadInt32(&maxInFlight)
+				if current <= seen || atomic.CompareAndSwapInt32(&maxInFlight, seen, current) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+			atomic.AddInt32(&inFlight, -1)
+			release()
+		}()
+	}
+	wg.Wait()
+	if maxInFlight > 2 {
+		t.Fatalf("observed %d concurrent requests, want at most 2", maxInFlight)
+	}
+}
+
+func TestRequestLimiterHonoursContext(t *testing.T) {
+	limiter := newRequestLimiter(0, 1)
+	release, err := limiter.acquire(context.Background())
+	if err != nil {
+		t.Fatalf("acquire() error = %v", err)
+	}
+	defer release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := limiter.acquire(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("acquire() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	slow := newRequestLimiter(0.1, 0)
+	if _, err := slow.acquire(context.Background()); err != nil {
+		t.Fatalf("acquire() error = %v", err)
+	}
+	if _, err := slow.acquire(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("acquire() on empty bucket error = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
@@ -0,0 +1,115 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	redacted = "<redacted>"
+
+	// maxLoggedBodySize bounds the part of a request or response body written
+	// to the TRACE log, domain data responses can be several megabytes.
+	maxLoggedBodySize = 64 * 1024
+)
+
+var (
+	pemPattern = regexp.MustCompile(`-----BEGIN [A-Z0-9 ]+-----[\s\S]*?-----END [A-Z0-9 ]+-----`)
+	// public keys are sent as ybase64 encoded PEM in the key attribute of
+	// PublicKeyEntry objects
+	publicKeyPattern = regexp.MustCompile(`("key"\s*:\s*)"[^"]*"`)
+
+	sensitiveHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie"}
+)
+
+// redactBody removes PEM material and public keys from a JSON body before it
+// is logged.
+func redactBody(body []byte) string {
+	truncated := len(body) > maxLoggedBodySize
+	if truncated {
+		body = body[:maxLoggedBodySize]
+	}
+	value := pemPattern.ReplaceAllString(string(body), redacted)
+	value = publicKeyPattern.ReplaceAllString(value, `$1"`+redacted+`"`)
+	if truncated {
+		value += "...(truncated)"
+	}
+	return value
+}
+
+// redactHeaders flattens the request headers for logging, hiding credentials.
+func redactHeaders(header http.Header) map[string]string {
+	values := make(map[string]string, len(header))
+	for name := range header {
+		values[name] = header.Get(name)
+	}
+	for _, name := range sensitiveHeaders {
+		if _, ok := values[http.CanonicalHeaderKey(name)]; ok {
+			values[http.CanonicalHeaderKey(name)] = redacted
+		}
+	}
+	return values
+}
+
+// domainAndEntity extracts the domain and the entity below it from a ZMS
+// request path such as /zms/v1/domain/sys.auth/role/admin/member/user.joe.
+// The path is best effort, some calls like domain creation carry the names
+// in the body instead.
+func domainAndEntity(path string) (string, string) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i, segment := range segments {
+		if (segment == "domain" || segment == "subdomain") && i+1 < len(segments) {
+			return segments[i+1], strings.Join(segments[i+2:], "/")
+		}
+	}
+	return "", ""
+}
+
+// logRoundTrip sends req through transport and logs the exchange: a DEBUG
+// summary with status and latency, and the redacted headers and bodies at
+// TRACE level. The response body is buffered so that it can be logged and
+// still be decoded by the zms client.
+func logRoundTrip(ctx context.Context, transport http.RoundTripper, req *http.Request) (*http.Response, error) {
+	domain, entity := domainAndEntity(req.URL.Path)
+	fields := map[string]interface{}{
+		"http_method": req.Method,
+		"path":        req.URL.Path,
+		"domain":      domain,
+		"entity":      entity,
+	}
+	traceFields := map[string]interface{}{"request_headers": redactHeaders(req.Header)}
+	if req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			data, _ := io.ReadAll(body)
+			traceFields["request_body"] = redactBody(data)
+		}
+	}
+
+	start := time.Now()
+	resp, err := transport.RoundTrip(req)
+	fields["latency_ms"] = time.Since(start).Milliseconds()
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.Debug(ctx, "ZMS request failed", fields)
+		return resp, err
+	}
+	fields["status_code"] = resp.StatusCode
+	tflog.Debug(ctx, "ZMS request", fields)
+
+	data, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+	traceFields["response_body"] = redactBody(data)
+	tflog.Trace(ctx, "ZMS request details", fields, traceFields)
+	return resp, nil
+}
@@ -0,0 +1,98 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestRedactBody(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{name: "plain", body: `{"name":"admin"}`, want: `{"name":"admin"}`},
+		{name: "public key", body: `{"publicKeys":[{"key":"LS0tLS1CRUdJTi0t","id":"0"}]}`, want: `{"publicKeys":[{"key":"<redacted>","id":"0"}]}`},
+		{name: "pem", body: `{"cert":"-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----"}`, want: `{"cert":"<redacted>"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := redactBody([]byte(tt.body)); got != tt.want {
+				t.Errorf("redactBody() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+	if got := redactBody(bytes.Repeat([]byte("a"), maxLoggedBodySize+1)); !strings.HasSuffix(got, "...(truncated)") {
+		t.Errorf("redactBody() did not truncate a large body")
+	}
+}
+
+func TestDomainAndEntity(t *testing.T) {
+	tests := []struct {
+		path   string
+		domain string
+		entity string
+	}{
+		{path: "/zms/v1/domain/sys.auth/role/admin/member/user.joe", domain: "sys.auth", entity: "role/admin/member/user.joe"},
+		{path: "/zms/v1/domain/sys.auth", domain: "sys.auth", entity: ""},
+		{path: "/zms/v1/subdomain/home/home.joe", domain: "home", entity: "home.joe"},
+		{path: "/zms/v1/domain", domain: "", entity: ""},
+	}
+	for _, tt := range tests {
+		domain, entity := domainAndEntity(tt.path)
+		if domain != tt.domain || entity != tt.entity {
+			t.Errorf("domainAndEntity(%s) = %s, %s, want %s, %s", tt.path, domain, entity, tt.domain, tt.entity)
+		}
+	}
+}
+
+func TestLogRoundTrip(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"code":404,"message":"role not found"}`))
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+	req, _ := http.NewRequest(http.MethodPut, server.URL+"/zms/v1/domain/sys.auth/service/api", strings.NewReader(`{"publicKeys":[{"key":"c2VjcmV0","id":"0"}]}`))
+	req.Header.Set("Authorization", "Bearer secret-token")
+
+	resp, err := logRoundTrip(ctx, http.DefaultTransport, req)
+	if err != nil {
+		t.Fatalf("logRoundTrip() error = %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	if string(body) != `{"code":404,"message":"role not found"}` {
+		t.Errorf("response body = %s, want it to be readable after logging", body)
+	}
+
+	logged := output.String()
+	if strings.Contains(logged, "secret") || strings.Contains(logged, "c2VjcmV0") {
+		t.Errorf("log output contains credentials: %s", logged)
+	}
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("logged %d entries, want 2", len(entries))
+	}
+	summary := entries[0]
+	if summary["@level"] != "debug" || summary["domain"] != "sys.auth" || summary["entity"] != "service/api" || summary["status_code"] != float64(404) {
+		t.Errorf("summary entry = %v", summary)
+	}
+	if _, ok := summary["latency_ms"]; !ok {
+		t.Errorf("summary entry has no latency_ms")
+	}
+	if entries[1]["@level"] != "trace" || !strings.Contains(entries[1]["response_body"].(string), "role not found") {
+		t.Errorf("trace entry = %v", entries[1])
+	}
+}
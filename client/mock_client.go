@@ -5,6 +5,7 @@
 package client
 
 import (
+	context "context"
 	reflect "reflect"
 
 	zms "github.com/AthenZ/athenz/clients/go/zms"
@@ -34,187 +35,1173 @@ func (m *MockZmsClient) EXPECT() *MockZmsClientMockRecorder {
 	return m.recorder
 }
 
+// DeleteAssertionPolicyVersion mocks base method.
+func (m *MockZmsClient) DeleteAssertionPolicyVersion(ctx context.Context, domainName, policyName, version string, assertionId int64, auditRef string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAssertionPolicyVersion", ctx, domainName, policyName, version, assertionId, auditRef)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAssertionPolicyVersion indicates an expected call of DeleteAssertionPolicyVersion.
+func (mr *MockZmsClientMockRecorder) DeleteAssertionPolicyVersion(ctx, domainName, policyName, version, assertionId, auditRef interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAssertionPolicyVersion", reflect.TypeOf((*MockZmsClient)(nil).DeleteAssertionPolicyVersion), ctx, domainName, policyName, version, assertionId, auditRef)
+}
+
+// DeleteDomainDependency mocks base method.
+func (m *MockZmsClient) DeleteDomainDependency(ctx context.Context, domain, service, auditRef string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDomainDependency", ctx, domain, service, auditRef)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDomainDependency indicates an expected call of DeleteDomainDependency.
+func (mr *MockZmsClientMockRecorder) DeleteDomainDependency(ctx, domain, service, auditRef interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDomainDependency", reflect.TypeOf((*MockZmsClient)(nil).DeleteDomainDependency), ctx, domain, service, auditRef)
+}
+
+// DeleteDomainTemplate mocks base method.
+func (m *MockZmsClient) DeleteDomainTemplate(ctx context.Context, domainName, templateName, auditRef string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDomainTemplate", ctx, domainName, templateName, auditRef)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDomainTemplate indicates an expected call of DeleteDomainTemplate.
+func (mr *MockZmsClientMockRecorder) DeleteDomainTemplate(ctx, domainName, templateName, auditRef interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDomainTemplate", reflect.TypeOf((*MockZmsClient)(nil).DeleteDomainTemplate), ctx, domainName, templateName, auditRef)
+}
+
+// DeleteEntity mocks base method.
+func (m *MockZmsClient) DeleteEntity(ctx context.Context, domainName, entityName, auditRef string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEntity", ctx, domainName, entityName, auditRef)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEntity indicates an expected call of DeleteEntity.
+func (mr *MockZmsClientMockRecorder) DeleteEntity(ctx, domainName, entityName, auditRef interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEntity", reflect.TypeOf((*MockZmsClient)(nil).DeleteEntity), ctx, domainName, entityName, auditRef)
+}
+
 // DeleteGroup mocks base method.
-func (m *MockZmsClient) DeleteGroup(domain, groupName, auditRef string) error {
+func (m *MockZmsClient) DeleteGroup(ctx context.Context, domain, groupName, auditRef string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteGroup", domain, groupName, auditRef)
+	ret := m.ctrl.Call(m, "DeleteGroup", ctx, domain, groupName, auditRef)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteGroup indicates an expected call of DeleteGroup.
-func (mr *MockZmsClientMockRecorder) DeleteGroup(domain, groupName, auditRef interface{}) *gomock.Call {
+func (mr *MockZmsClientMockRecorder) DeleteGroup(ctx, domain, groupName, auditRef interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGroup", reflect.TypeOf((*MockZmsClient)(nil).DeleteGroup), domain, groupName, auditRef)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGroup", reflect.TypeOf((*MockZmsClient)(nil).DeleteGroup), ctx, domain, groupName, auditRef)
 }
 
 // DeleteGroupMembership mocks base method.
-func (m *MockZmsClient) DeleteGroupMembership(domain, groupName string, member zms.GroupMemberName, auditRef string) error {
+func (m *MockZmsClient) DeleteGroupMembership(ctx context.Context, domain, groupName string, member zms.GroupMemberName, auditRef string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteGroupMembership", domain, groupName, member, auditRef)
+	ret := m.ctrl.Call(m, "DeleteGroupMembership", ctx, domain, groupName, member, auditRef)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteGroupMembership indicates an expected call of DeleteGroupMembership.
-func (mr *MockZmsClientMockRecorder) DeleteGroupMembership(domain, groupName, member, auditRef interface{}) *gomock.Call {
+func (mr *MockZmsClientMockRecorder) DeleteGroupMembership(ctx, domain, groupName, member, auditRef interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGroupMembership", reflect.TypeOf((*MockZmsClient)(nil).DeleteGroupMembership), domain, groupName, member, auditRef)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGroupMembership", reflect.TypeOf((*MockZmsClient)(nil).DeleteGroupMembership), ctx, domain, groupName, member, auditRef)
 }
 
 // DeleteMembership mocks base method.
-func (m *MockZmsClient) DeleteMembership(domain, roleMember string, member zms.MemberName, auditRef string) error {
+func (m *MockZmsClient) DeleteMembership(ctx context.Context, domain, roleMember string, member zms.MemberName, auditRef string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteMembership", domain, roleMember, member, auditRef)
+	ret := m.ctrl.Call(m, "DeleteMembership", ctx, domain, roleMember, member, auditRef)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteMembership indicates an expected call of DeleteMembership.
-func (mr *MockZmsClientMockRecorder) DeleteMembership(domain, roleMember, member, auditRef interface{}) *gomock.Call {
+func (mr *MockZmsClientMockRecorder) DeleteMembership(ctx, domain, roleMember, member, auditRef interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMembership", reflect.TypeOf((*MockZmsClient)(nil).DeleteMembership), domain, roleMember, member, auditRef)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMembership", reflect.TypeOf((*MockZmsClient)(nil).DeleteMembership), ctx, domain, roleMember, member, auditRef)
+}
+
+// DeletePendingGroupMembership mocks base method.
+func (m *MockZmsClient) DeletePendingGroupMembership(ctx context.Context, domain, groupName string, memberName zms.GroupMemberName, auditRef string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePendingGroupMembership", ctx, domain, groupName, memberName, auditRef)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePendingGroupMembership indicates an expected call of DeletePendingGroupMembership.
+func (mr *MockZmsClientMockRecorder) DeletePendingGroupMembership(ctx, domain, groupName, memberName, auditRef interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePendingGroupMembership", reflect.TypeOf((*MockZmsClient)(nil).DeletePendingGroupMembership), ctx, domain, groupName, memberName, auditRef)
+}
+
+// DeletePendingMembership mocks base method.
+func (m *MockZmsClient) DeletePendingMembership(ctx context.Context, domain, roleName string, memberName zms.MemberName, auditRef string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePendingMembership", ctx, domain, roleName, memberName, auditRef)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePendingMembership indicates an expected call of DeletePendingMembership.
+func (mr *MockZmsClientMockRecorder) DeletePendingMembership(ctx, domain, roleName, memberName, auditRef interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePendingMembership", reflect.TypeOf((*MockZmsClient)(nil).DeletePendingMembership), ctx, domain, roleName, memberName, auditRef)
 }
 
 // DeletePolicy mocks base method.
-func (m *MockZmsClient) DeletePolicy(domain, policyName, auditRef string) error {
+func (m *MockZmsClient) DeletePolicy(ctx context.Context, domain, policyName, auditRef string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeletePolicy", domain, policyName, auditRef)
+	ret := m.ctrl.Call(m, "DeletePolicy", ctx, domain, policyName, auditRef)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeletePolicy indicates an expected call of DeletePolicy.
-func (mr *MockZmsClientMockRecorder) DeletePolicy(domain, policyName, auditRef interface{}) *gomock.Call {
+func (mr *MockZmsClientMockRecorder) DeletePolicy(ctx, domain, policyName, auditRef interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePolicy", reflect.TypeOf((*MockZmsClient)(nil).DeletePolicy), ctx, domain, policyName, auditRef)
+}
+
+// DeletePolicyVersion mocks base method.
+func (m *MockZmsClient) DeletePolicyVersion(ctx context.Context, domainName, policyName, version, auditRef string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePolicyVersion", ctx, domainName, policyName, version, auditRef)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePolicyVersion indicates an expected call of DeletePolicyVersion.
+func (mr *MockZmsClientMockRecorder) DeletePolicyVersion(ctx, domainName, policyName, version, auditRef interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePolicyVersion", reflect.TypeOf((*MockZmsClient)(nil).DeletePolicyVersion), ctx, domainName, policyName, version, auditRef)
+}
+
+// DeleteProviderResourceGroupRoles mocks base method.
+func (m *MockZmsClient) DeleteProviderResourceGroupRoles(ctx context.Context, domain, providerDomain, providerService, resourceGroup, auditRef string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProviderResourceGroupRoles", ctx, domain, providerDomain, providerService, resourceGroup, auditRef)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProviderResourceGroupRoles indicates an expected call of DeleteProviderResourceGroupRoles.
+func (mr *MockZmsClientMockRecorder) DeleteProviderResourceGroupRoles(ctx, domain, providerDomain, providerService, resourceGroup, auditRef interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePolicy", reflect.TypeOf((*MockZmsClient)(nil).DeletePolicy), domain, policyName, auditRef)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProviderResourceGroupRoles", reflect.TypeOf((*MockZmsClient)(nil).DeleteProviderResourceGroupRoles), ctx, domain, providerDomain, providerService, resourceGroup, auditRef)
+}
+
+// DeletePublicKeyEntry mocks base method.
+func (m *MockZmsClient) DeletePublicKeyEntry(ctx context.Context, domain, serviceName, keyId, auditRef string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePublicKeyEntry", ctx, domain, serviceName, keyId, auditRef)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePublicKeyEntry indicates an expected call of DeletePublicKeyEntry.
+func (mr *MockZmsClientMockRecorder) DeletePublicKeyEntry(ctx, domain, serviceName, keyId, auditRef interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePublicKeyEntry", reflect.TypeOf((*MockZmsClient)(nil).DeletePublicKeyEntry), ctx, domain, serviceName, keyId, auditRef)
+}
+
+// DeleteQuota mocks base method.
+func (m *MockZmsClient) DeleteQuota(ctx context.Context, domainName, auditRef string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteQuota", ctx, domainName, auditRef)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteQuota indicates an expected call of DeleteQuota.
+func (mr *MockZmsClientMockRecorder) DeleteQuota(ctx, domainName, auditRef interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteQuota", reflect.TypeOf((*MockZmsClient)(nil).DeleteQuota), ctx, domainName, auditRef)
 }
 
 // DeleteRole mocks base method.
-func (m *MockZmsClient) DeleteRole(domain, roleName, auditRef string) error {
+func (m *MockZmsClient) DeleteRole(ctx context.Context, domain, roleName, auditRef string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteRole", domain, roleName, auditRef)
+	ret := m.ctrl.Call(m, "DeleteRole", ctx, domain, roleName, auditRef)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteRole indicates an expected call of DeleteRole.
-func (mr *MockZmsClientMockRecorder) DeleteRole(domain, roleName, auditRef interface{}) *gomock.Call {
+func (mr *MockZmsClientMockRecorder) DeleteRole(ctx, domain, roleName, auditRef interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRole", reflect.TypeOf((*MockZmsClient)(nil).DeleteRole), ctx, domain, roleName, auditRef)
+}
+
+// DeleteServiceIdentity mocks base method.
+func (m *MockZmsClient) DeleteServiceIdentity(ctx context.Context, domain, serviceName, auditRef string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteServiceIdentity", ctx, domain, serviceName, auditRef)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteServiceIdentity indicates an expected call of DeleteServiceIdentity.
+func (mr *MockZmsClientMockRecorder) DeleteServiceIdentity(ctx, domain, serviceName, auditRef interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteServiceIdentity", reflect.TypeOf((*MockZmsClient)(nil).DeleteServiceIdentity), ctx, domain, serviceName, auditRef)
+}
+
+// DeleteSubDomain mocks base method.
+func (m *MockZmsClient) DeleteSubDomain(ctx context.Context, parentDomain, subDomainName, auditRef string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSubDomain", ctx, parentDomain, subDomainName, auditRef)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSubDomain indicates an expected call of DeleteSubDomain.
+func (mr *MockZmsClientMockRecorder) DeleteSubDomain(ctx, parentDomain, subDomainName, auditRef interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubDomain", reflect.TypeOf((*MockZmsClient)(nil).DeleteSubDomain), ctx, parentDomain, subDomainName, auditRef)
+}
+
+// DeleteTenancy mocks base method.
+func (m *MockZmsClient) DeleteTenancy(ctx context.Context, domain, providerService, auditRef string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTenancy", ctx, domain, providerService, auditRef)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTenancy indicates an expected call of DeleteTenancy.
+func (mr *MockZmsClientMockRecorder) DeleteTenancy(ctx, domain, providerService, auditRef interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTenancy", reflect.TypeOf((*MockZmsClient)(nil).DeleteTenancy), ctx, domain, providerService, auditRef)
+}
+
+// DeleteTopLevelDomain mocks base method.
+func (m *MockZmsClient) DeleteTopLevelDomain(ctx context.Context, name, auditRef string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTopLevelDomain", ctx, name, auditRef)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTopLevelDomain indicates an expected call of DeleteTopLevelDomain.
+func (mr *MockZmsClientMockRecorder) DeleteTopLevelDomain(ctx, name, auditRef interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTopLevelDomain", reflect.TypeOf((*MockZmsClient)(nil).DeleteTopLevelDomain), ctx, name, auditRef)
+}
+
+// DeleteUserDomain mocks base method.
+func (m *MockZmsClient) DeleteUserDomain(ctx context.Context, domainName, auditRef string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserDomain", ctx, domainName, auditRef)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserDomain indicates an expected call of DeleteUserDomain.
+func (mr *MockZmsClientMockRecorder) DeleteUserDomain(ctx, domainName, auditRef interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserDomain", reflect.TypeOf((*MockZmsClient)(nil).DeleteUserDomain), ctx, domainName, auditRef)
+}
+
+// GetDependentDomainList mocks base method.
+func (m *MockZmsClient) GetDependentDomainList(ctx context.Context, service string) (*zms.DomainList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDependentDomainList", ctx, service)
+	ret0, _ := ret[0].(*zms.DomainList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDependentDomainList indicates an expected call of GetDependentDomainList.
+func (mr *MockZmsClientMockRecorder) GetDependentDomainList(ctx, service interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRole", reflect.TypeOf((*MockZmsClient)(nil).DeleteRole), domain, roleName, auditRef)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDependentDomainList", reflect.TypeOf((*MockZmsClient)(nil).GetDependentDomainList), ctx, service)
+}
+
+// GetDependentServiceList mocks base method.
+func (m *MockZmsClient) GetDependentServiceList(ctx context.Context, domain string) (*zms.ServiceIdentityList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDependentServiceList", ctx, domain)
+	ret0, _ := ret[0].(*zms.ServiceIdentityList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDependentServiceList indicates an expected call of GetDependentServiceList.
+func (mr *MockZmsClientMockRecorder) GetDependentServiceList(ctx, domain interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDependentServiceList", reflect.TypeOf((*MockZmsClient)(nil).GetDependentServiceList), ctx, domain)
+}
+
+// GetDomain mocks base method.
+func (m *MockZmsClient) GetDomain(ctx context.Context, domainName string) (*zms.Domain, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDomain", ctx, domainName)
+	ret0, _ := ret[0].(*zms.Domain)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDomain indicates an expected call of GetDomain.
+func (mr *MockZmsClientMockRecorder) GetDomain(ctx, domainName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDomain", reflect.TypeOf((*MockZmsClient)(nil).GetDomain), ctx, domainName)
+}
+
+// GetDomainList mocks base method.
+func (m *MockZmsClient) GetDomainList(ctx context.Context, filter DomainListFilter, skip string) (*zms.DomainList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDomainList", ctx, filter, skip)
+	ret0, _ := ret[0].(*zms.DomainList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDomainList indicates an expected call of GetDomainList.
+func (mr *MockZmsClientMockRecorder) GetDomainList(ctx, filter, skip interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDomainList", reflect.TypeOf((*MockZmsClient)(nil).GetDomainList), ctx, filter, skip)
+}
+
+// GetDomainTemplateDetailsList mocks base method.
+func (m *MockZmsClient) GetDomainTemplateDetailsList(ctx context.Context, domainName string) (*zms.DomainTemplateDetailsList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDomainTemplateDetailsList", ctx, domainName)
+	ret0, _ := ret[0].(*zms.DomainTemplateDetailsList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDomainTemplateDetailsList indicates an expected call of GetDomainTemplateDetailsList.
+func (mr *MockZmsClientMockRecorder) GetDomainTemplateDetailsList(ctx, domainName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDomainTemplateDetailsList", reflect.TypeOf((*MockZmsClient)(nil).GetDomainTemplateDetailsList), ctx, domainName)
+}
+
+// GetEntity mocks base method.
+func (m *MockZmsClient) GetEntity(ctx context.Context, domainName, entityName string) (*zms.Entity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEntity", ctx, domainName, entityName)
+	ret0, _ := ret[0].(*zms.Entity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEntity indicates an expected call of GetEntity.
+func (mr *MockZmsClientMockRecorder) GetEntity(ctx, domainName, entityName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntity", reflect.TypeOf((*MockZmsClient)(nil).GetEntity), ctx, domainName, entityName)
+}
+
+// GetEntityList mocks base method.
+func (m *MockZmsClient) GetEntityList(ctx context.Context, domainName string) (*zms.EntityList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEntityList", ctx, domainName)
+	ret0, _ := ret[0].(*zms.EntityList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEntityList indicates an expected call of GetEntityList.
+func (mr *MockZmsClientMockRecorder) GetEntityList(ctx, domainName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntityList", reflect.TypeOf((*MockZmsClient)(nil).GetEntityList), ctx, domainName)
 }
 
 // GetGroup mocks base method.
-func (m *MockZmsClient) GetGroup(domain, groupName string) (*zms.Group, error) {
+func (m *MockZmsClient) GetGroup(ctx context.Context, domain, groupName string) (*zms.Group, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetGroup", domain, groupName)
+	ret := m.ctrl.Call(m, "GetGroup", ctx, domain, groupName)
 	ret0, _ := ret[0].(*zms.Group)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetGroup indicates an expected call of GetGroup.
-func (mr *MockZmsClientMockRecorder) GetGroup(domain, groupName interface{}) *gomock.Call {
+func (mr *MockZmsClientMockRecorder) GetGroup(ctx, domain, groupName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroup", reflect.TypeOf((*MockZmsClient)(nil).GetGroup), domain, groupName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroup", reflect.TypeOf((*MockZmsClient)(nil).GetGroup), ctx, domain, groupName)
 }
 
-// GetPolicy mocks base method.
-func (m *MockZmsClient) GetPolicy(domain, policy string) (*zms.Policy, error) {
+// GetGroupMembership mocks base method.
+func (m *MockZmsClient) GetGroupMembership(ctx context.Context, domain, groupName string, memberName zms.GroupMemberName) (*zms.GroupMembership, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPolicy", domain, policy)
-	ret0, _ := ret[0].(*zms.Policy)
+	ret := m.ctrl.Call(m, "GetGroupMembership", ctx, domain, groupName, memberName)
+	ret0, _ := ret[0].(*zms.GroupMembership)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetPolicy indicates an expected call of GetPolicy.
-func (mr *MockZmsClientMockRecorder) GetPolicy(domain, policy interface{}) *gomock.Call {
+// GetGroupMembership indicates an expected call of GetGroupMembership.
+func (mr *MockZmsClientMockRecorder) GetGroupMembership(ctx, domain, groupName, memberName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPolicy", reflect.TypeOf((*MockZmsClient)(nil).GetPolicy), domain, policy)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupMembership", reflect.TypeOf((*MockZmsClient)(nil).GetGroupMembership), ctx, domain, groupName, memberName)
 }
 
-// GetRole mocks base method.
-func (m *MockZmsClient) GetRole(domain, roleName string) (*zms.Role, error) {
+// GetGroupMetaResourceState mocks base method.
+func (m *MockZmsClient) GetGroupMetaResourceState(groupMetaResourceState, requestedState int) bool {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetRole", domain, roleName)
-	ret0, _ := ret[0].(*zms.Role)
+	ret := m.ctrl.Call(m, "GetGroupMetaResourceState", groupMetaResourceState, requestedState)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// GetGroupMetaResourceState indicates an expected call of GetGroupMetaResourceState.
+func (mr *MockZmsClientMockRecorder) GetGroupMetaResourceState(groupMetaResourceState, requestedState interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupMetaResourceState", reflect.TypeOf((*MockZmsClient)(nil).GetGroupMetaResourceState), groupMetaResourceState, requestedState)
+}
+
+// GetGroupWithPendingMembers mocks base method.
+func (m *MockZmsClient) GetGroupWithPendingMembers(ctx context.Context, domain, groupName string) (*zms.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupWithPendingMembers", ctx, domain, groupName)
+	ret0, _ := ret[0].(*zms.Group)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetRole indicates an expected call of GetRole.
-func (mr *MockZmsClientMockRecorder) GetRole(domain, roleName interface{}) *gomock.Call {
+// GetGroupWithPendingMembers indicates an expected call of GetGroupWithPendingMembers.
+func (mr *MockZmsClientMockRecorder) GetGroupWithPendingMembers(ctx, domain, groupName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRole", reflect.TypeOf((*MockZmsClient)(nil).GetRole), domain, roleName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupWithPendingMembers", reflect.TypeOf((*MockZmsClient)(nil).GetGroupWithPendingMembers), ctx, domain, groupName)
 }
 
-// PutGroup mocks base method.
-func (m *MockZmsClient) PutGroup(domain, groupName, auditRef string, group *zms.Group) error {
+// GetGroups mocks base method.
+func (m *MockZmsClient) GetGroups(ctx context.Context, domainName string, members *bool, tagKey, tagValue string) (*zms.Groups, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PutGroup", domain, groupName, auditRef, group)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetGroups", ctx, domainName, members, tagKey, tagValue)
+	ret0, _ := ret[0].(*zms.Groups)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// PutGroup indicates an expected call of PutGroup.
-func (mr *MockZmsClientMockRecorder) PutGroup(domain, groupName, auditRef, group interface{}) *gomock.Call {
+// GetGroups indicates an expected call of GetGroups.
+func (mr *MockZmsClientMockRecorder) GetGroups(ctx, domainName, members, tagKey, tagValue interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutGroup", reflect.TypeOf((*MockZmsClient)(nil).PutGroup), domain, groupName, auditRef, group)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroups", reflect.TypeOf((*MockZmsClient)(nil).GetGroups), ctx, domainName, members, tagKey, tagValue)
 }
 
-// PutGroupMembership mocks base method.
-func (m *MockZmsClient) PutGroupMembership(domain, groupName string, memberName zms.GroupMemberName, auditRef string, membership *zms.GroupMembership) error {
+// GetHostServices mocks base method.
+func (m *MockZmsClient) GetHostServices(ctx context.Context, host string) (*HostServices, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PutGroupMembership", domain, groupName, memberName, auditRef, membership)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetHostServices", ctx, host)
+	ret0, _ := ret[0].(*HostServices)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// PutGroupMembership indicates an expected call of PutGroupMembership.
-func (mr *MockZmsClientMockRecorder) PutGroupMembership(domain, groupName, memberName, auditRef, membership interface{}) *gomock.Call {
+// GetHostServices indicates an expected call of GetHostServices.
+func (mr *MockZmsClientMockRecorder) GetHostServices(ctx, host interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutGroupMembership", reflect.TypeOf((*MockZmsClient)(nil).PutGroupMembership), domain, groupName, memberName, auditRef, membership)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostServices", reflect.TypeOf((*MockZmsClient)(nil).GetHostServices), ctx, host)
 }
 
-// PutMembership mocks base method.
-func (m *MockZmsClient) PutMembership(domain, roleName string, memberName zms.MemberName, auditRef string, membership *zms.Membership) error {
+// GetMembership mocks base method.
+func (m *MockZmsClient) GetMembership(ctx context.Context, domain, roleName string, memberName zms.MemberName) (*zms.Membership, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PutMembership", domain, roleName, memberName, auditRef, membership)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetMembership", ctx, domain, roleName, memberName)
+	ret0, _ := ret[0].(*zms.Membership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// PutMembership indicates an expected call of PutMembership.
-func (mr *MockZmsClientMockRecorder) PutMembership(domain, roleName, memberName, auditRef, membership interface{}) *gomock.Call {
+// GetMembership indicates an expected call of GetMembership.
+func (mr *MockZmsClientMockRecorder) GetMembership(ctx, domain, roleName, memberName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutMembership", reflect.TypeOf((*MockZmsClient)(nil).PutMembership), domain, roleName, memberName, auditRef, membership)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMembership", reflect.TypeOf((*MockZmsClient)(nil).GetMembership), ctx, domain, roleName, memberName)
 }
 
-// PutPolicy mocks base method.
-func (m *MockZmsClient) PutPolicy(domain, policyName, auditRef string, policy *zms.Policy) error {
+// GetPolicies mocks base method.
+func (m *MockZmsClient) GetPolicies(ctx context.Context, domainName string, assertions, includeNonActive bool, tagKey, tagValue string) (*zms.Policies, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PutPolicy", domain, policyName, auditRef, policy)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetPolicies", ctx, domainName, assertions, includeNonActive, tagKey, tagValue)
+	ret0, _ := ret[0].(*zms.Policies)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// PutPolicy indicates an expected call of PutPolicy.
-func (mr *MockZmsClientMockRecorder) PutPolicy(domain, policyName, auditRef, policy interface{}) *gomock.Call {
+// GetPolicies indicates an expected call of GetPolicies.
+func (mr *MockZmsClientMockRecorder) GetPolicies(ctx, domainName, assertions, includeNonActive, tagKey, tagValue interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutPolicy", reflect.TypeOf((*MockZmsClient)(nil).PutPolicy), domain, policyName, auditRef, policy)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPolicies", reflect.TypeOf((*MockZmsClient)(nil).GetPolicies), ctx, domainName, assertions, includeNonActive, tagKey, tagValue)
 }
 
-// PutRole mocks base method.
-func (m *MockZmsClient) PutRole(domain, roleName, auditRef string, role *zms.Role) error {
+// GetPolicy mocks base method.
+func (m *MockZmsClient) GetPolicy(ctx context.Context, domain, policy string) (*zms.Policy, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PutRole", domain, roleName, auditRef, role)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetPolicy", ctx, domain, policy)
+	ret0, _ := ret[0].(*zms.Policy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// PutRole indicates an expected call of PutRole.
-func (mr *MockZmsClientMockRecorder) PutRole(domain, roleName, auditRef, role interface{}) *gomock.Call {
+// GetPolicy indicates an expected call of GetPolicy.
+func (mr *MockZmsClientMockRecorder) GetPolicy(ctx, domain, policy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPolicy", reflect.TypeOf((*MockZmsClient)(nil).GetPolicy), ctx, domain, policy)
+}
+
+// GetPolicyList mocks base method.
+func (m *MockZmsClient) GetPolicyList(ctx context.Context, domainName string, limit *int32, skip string) (*zms.PolicyList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPolicyList", ctx, domainName, limit, skip)
+	ret0, _ := ret[0].(*zms.PolicyList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPolicyList indicates an expected call of GetPolicyList.
+func (mr *MockZmsClientMockRecorder) GetPolicyList(ctx, domainName, limit, skip interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPolicyList", reflect.TypeOf((*MockZmsClient)(nil).GetPolicyList), ctx, domainName, limit, skip)
+}
+
+// GetPolicyVersion mocks base method.
+func (m *MockZmsClient) GetPolicyVersion(ctx context.Context, domainName, policyName, version string) (*zms.Policy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPolicyVersion", ctx, domainName, policyName, version)
+	ret0, _ := ret[0].(*zms.Policy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPolicyVersion indicates an expected call of GetPolicyVersion.
+func (mr *MockZmsClientMockRecorder) GetPolicyVersion(ctx, domainName, policyName, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPolicyVersion", reflect.TypeOf((*MockZmsClient)(nil).GetPolicyVersion), ctx, domainName, policyName, version)
+}
+
+// GetPolicyVersionList mocks base method.
+func (m *MockZmsClient) GetPolicyVersionList(ctx context.Context, domainName, policyName string) (*zms.PolicyList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPolicyVersionList", ctx, domainName, policyName)
+	ret0, _ := ret[0].(*zms.PolicyList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPolicyVersionList indicates an expected call of GetPolicyVersionList.
+func (mr *MockZmsClientMockRecorder) GetPolicyVersionList(ctx, domainName, policyName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPolicyVersionList", reflect.TypeOf((*MockZmsClient)(nil).GetPolicyVersionList), ctx, domainName, policyName)
+}
+
+// GetPrincipalGroups mocks base method.
+func (m *MockZmsClient) GetPrincipalGroups(ctx context.Context, principal, domain string) (*zms.DomainGroupMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPrincipalGroups", ctx, principal, domain)
+	ret0, _ := ret[0].(*zms.DomainGroupMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPrincipalGroups indicates an expected call of GetPrincipalGroups.
+func (mr *MockZmsClientMockRecorder) GetPrincipalGroups(ctx, principal, domain interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrincipalGroups", reflect.TypeOf((*MockZmsClient)(nil).GetPrincipalGroups), ctx, principal, domain)
+}
+
+// GetPrincipalRoles mocks base method.
+func (m *MockZmsClient) GetPrincipalRoles(ctx context.Context, principal, domain string, expand bool) (*zms.DomainRoleMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPrincipalRoles", ctx, principal, domain, expand)
+	ret0, _ := ret[0].(*zms.DomainRoleMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPrincipalRoles indicates an expected call of GetPrincipalRoles.
+func (mr *MockZmsClientMockRecorder) GetPrincipalRoles(ctx, principal, domain, expand interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrincipalRoles", reflect.TypeOf((*MockZmsClient)(nil).GetPrincipalRoles), ctx, principal, domain, expand)
+}
+
+// GetProviderResourceGroupRoles mocks base method.
+func (m *MockZmsClient) GetProviderResourceGroupRoles(ctx context.Context, domain, providerDomain, providerService, resourceGroup string) (*zms.ProviderResourceGroupRoles, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProviderResourceGroupRoles", ctx, domain, providerDomain, providerService, resourceGroup)
+	ret0, _ := ret[0].(*zms.ProviderResourceGroupRoles)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProviderResourceGroupRoles indicates an expected call of GetProviderResourceGroupRoles.
+func (mr *MockZmsClientMockRecorder) GetProviderResourceGroupRoles(ctx, domain, providerDomain, providerService, resourceGroup interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProviderResourceGroupRoles", reflect.TypeOf((*MockZmsClient)(nil).GetProviderResourceGroupRoles), ctx, domain, providerDomain, providerService, resourceGroup)
+}
+
+// GetPublicKeyEntry mocks base method.
+func (m *MockZmsClient) GetPublicKeyEntry(ctx context.Context, domain, serviceName, keyId string) (*zms.PublicKeyEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPublicKeyEntry", ctx, domain, serviceName, keyId)
+	ret0, _ := ret[0].(*zms.PublicKeyEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPublicKeyEntry indicates an expected call of GetPublicKeyEntry.
+func (mr *MockZmsClientMockRecorder) GetPublicKeyEntry(ctx, domain, serviceName, keyId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublicKeyEntry", reflect.TypeOf((*MockZmsClient)(nil).GetPublicKeyEntry), ctx, domain, serviceName, keyId)
+}
+
+// GetQuota mocks base method.
+func (m *MockZmsClient) GetQuota(ctx context.Context, domainName string) (*zms.Quota, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuota", ctx, domainName)
+	ret0, _ := ret[0].(*zms.Quota)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuota indicates an expected call of GetQuota.
+func (mr *MockZmsClientMockRecorder) GetQuota(ctx, domainName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuota", reflect.TypeOf((*MockZmsClient)(nil).GetQuota), ctx, domainName)
+}
+
+// GetRole mocks base method.
+func (m *MockZmsClient) GetRole(ctx context.Context, domain, roleName string) (*zms.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRole", ctx, domain, roleName)
+	ret0, _ := ret[0].(*zms.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRole indicates an expected call of GetRole.
+func (mr *MockZmsClientMockRecorder) GetRole(ctx, domain, roleName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRole", reflect.TypeOf((*MockZmsClient)(nil).GetRole), ctx, domain, roleName)
+}
+
+// GetRoleList mocks base method.
+func (m *MockZmsClient) GetRoleList(ctx context.Context, domainName string, limit *int32, skip string) (*zms.RoleList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoleList", ctx, domainName, limit, skip)
+	ret0, _ := ret[0].(*zms.RoleList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoleList indicates an expected call of GetRoleList.
+func (mr *MockZmsClientMockRecorder) GetRoleList(ctx, domainName, limit, skip interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoleList", reflect.TypeOf((*MockZmsClient)(nil).GetRoleList), ctx, domainName, limit, skip)
+}
+
+// GetRoleMetaResourceState mocks base method.
+func (m *MockZmsClient) GetRoleMetaResourceState(roleMetaResourceState, requestedState int) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoleMetaResourceState", roleMetaResourceState, requestedState)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// GetRoleMetaResourceState indicates an expected call of GetRoleMetaResourceState.
+func (mr *MockZmsClientMockRecorder) GetRoleMetaResourceState(roleMetaResourceState, requestedState interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoleMetaResourceState", reflect.TypeOf((*MockZmsClient)(nil).GetRoleMetaResourceState), roleMetaResourceState, requestedState)
+}
+
+// GetRoleWithPendingMembers mocks base method.
+func (m *MockZmsClient) GetRoleWithPendingMembers(ctx context.Context, domain, roleName string) (*zms.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoleWithPendingMembers", ctx, domain, roleName)
+	ret0, _ := ret[0].(*zms.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoleWithPendingMembers indicates an expected call of GetRoleWithPendingMembers.
+func (mr *MockZmsClientMockRecorder) GetRoleWithPendingMembers(ctx, domain, roleName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoleWithPendingMembers", reflect.TypeOf((*MockZmsClient)(nil).GetRoleWithPendingMembers), ctx, domain, roleName)
+}
+
+// GetRoles mocks base method.
+func (m *MockZmsClient) GetRoles(ctx context.Context, domainName string, members *bool, tagKey, tagValue string) (*zms.Roles, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoles", ctx, domainName, members, tagKey, tagValue)
+	ret0, _ := ret[0].(*zms.Roles)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoles indicates an expected call of GetRoles.
+func (mr *MockZmsClientMockRecorder) GetRoles(ctx, domainName, members, tagKey, tagValue interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoles", reflect.TypeOf((*MockZmsClient)(nil).GetRoles), ctx, domainName, members, tagKey, tagValue)
+}
+
+// GetServerTemplateDetailsList mocks base method.
+func (m *MockZmsClient) GetServerTemplateDetailsList(ctx context.Context) (*zms.DomainTemplateDetailsList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServerTemplateDetailsList", ctx)
+	ret0, _ := ret[0].(*zms.DomainTemplateDetailsList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServerTemplateDetailsList indicates an expected call of GetServerTemplateDetailsList.
+func (mr *MockZmsClientMockRecorder) GetServerTemplateDetailsList(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServerTemplateDetailsList", reflect.TypeOf((*MockZmsClient)(nil).GetServerTemplateDetailsList), ctx)
+}
+
+// GetServiceIdentities mocks base method.
+func (m *MockZmsClient) GetServiceIdentities(ctx context.Context, domain string, publicKeys, hosts bool, tagKey, tagValue string) (*zms.ServiceIdentities, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceIdentities", ctx, domain, publicKeys, hosts, tagKey, tagValue)
+	ret0, _ := ret[0].(*zms.ServiceIdentities)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServiceIdentities indicates an expected call of GetServiceIdentities.
+func (mr *MockZmsClientMockRecorder) GetServiceIdentities(ctx, domain, publicKeys, hosts, tagKey, tagValue interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceIdentities", reflect.TypeOf((*MockZmsClient)(nil).GetServiceIdentities), ctx, domain, publicKeys, hosts, tagKey, tagValue)
+}
+
+// GetServiceIdentity mocks base method.
+func (m *MockZmsClient) GetServiceIdentity(ctx context.Context, domain, serviceName string) (*zms.ServiceIdentity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceIdentity", ctx, domain, serviceName)
+	ret0, _ := ret[0].(*zms.ServiceIdentity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServiceIdentity indicates an expected call of GetServiceIdentity.
+func (mr *MockZmsClientMockRecorder) GetServiceIdentity(ctx, domain, serviceName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceIdentity", reflect.TypeOf((*MockZmsClient)(nil).GetServiceIdentity), ctx, domain, serviceName)
+}
+
+// GetServiceIdentityList mocks base method.
+func (m *MockZmsClient) GetServiceIdentityList(ctx context.Context, domainName string, limit *int32, skip string) (*zms.ServiceIdentityList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceIdentityList", ctx, domainName, limit, skip)
+	ret0, _ := ret[0].(*zms.ServiceIdentityList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServiceIdentityList indicates an expected call of GetServiceIdentityList.
+func (mr *MockZmsClientMockRecorder) GetServiceIdentityList(ctx, domainName, limit, skip interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceIdentityList", reflect.TypeOf((*MockZmsClient)(nil).GetServiceIdentityList), ctx, domainName, limit, skip)
+}
+
+// GetTemplate mocks base method.
+func (m *MockZmsClient) GetTemplate(ctx context.Context, templateName string) (*zms.Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTemplate", ctx, templateName)
+	ret0, _ := ret[0].(*zms.Template)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTemplate indicates an expected call of GetTemplate.
+func (mr *MockZmsClientMockRecorder) GetTemplate(ctx, templateName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplate", reflect.TypeOf((*MockZmsClient)(nil).GetTemplate), ctx, templateName)
+}
+
+// PostSubDomain mocks base method.
+func (m *MockZmsClient) PostSubDomain(ctx context.Context, parentDomain, auditRef string, detail *zms.SubDomain) (*zms.Domain, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostSubDomain", ctx, parentDomain, auditRef, detail)
+	ret0, _ := ret[0].(*zms.Domain)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostSubDomain indicates an expected call of PostSubDomain.
+func (mr *MockZmsClientMockRecorder) PostSubDomain(ctx, parentDomain, auditRef, detail interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostSubDomain", reflect.TypeOf((*MockZmsClient)(nil).PostSubDomain), ctx, parentDomain, auditRef, detail)
+}
+
+// PostTopLevelDomain mocks base method.
+func (m *MockZmsClient) PostTopLevelDomain(ctx context.Context, auditRef string, detail *zms.TopLevelDomain) (*zms.Domain, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostTopLevelDomain", ctx, auditRef, detail)
+	ret0, _ := ret[0].(*zms.Domain)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostTopLevelDomain indicates an expected call of PostTopLevelDomain.
+func (mr *MockZmsClientMockRecorder) PostTopLevelDomain(ctx, auditRef, detail interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostTopLevelDomain", reflect.TypeOf((*MockZmsClient)(nil).PostTopLevelDomain), ctx, auditRef, detail)
+}
+
+// PostUserDomain mocks base method.
+func (m *MockZmsClient) PostUserDomain(ctx context.Context, domainName, auditRef string, detail *zms.UserDomain) (*zms.Domain, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostUserDomain", ctx, domainName, auditRef, detail)
+	ret0, _ := ret[0].(*zms.Domain)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostUserDomain indicates an expected call of PostUserDomain.
+func (mr *MockZmsClientMockRecorder) PostUserDomain(ctx, domainName, auditRef, detail interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostUserDomain", reflect.TypeOf((*MockZmsClient)(nil).PostUserDomain), ctx, domainName, auditRef, detail)
+}
+
+// PutAssertionConditions mocks base method.
+func (m *MockZmsClient) PutAssertionConditions(ctx context.Context, domainName, policyName string, assertionId int64, auditRef string, assertionConditions *zms.AssertionConditions) (*zms.AssertionConditions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutAssertionConditions", ctx, domainName, policyName, assertionId, auditRef, assertionConditions)
+	ret0, _ := ret[0].(*zms.AssertionConditions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutAssertionConditions indicates an expected call of PutAssertionConditions.
+func (mr *MockZmsClientMockRecorder) PutAssertionConditions(ctx, domainName, policyName, assertionId, auditRef, assertionConditions interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutAssertionConditions", reflect.TypeOf((*MockZmsClient)(nil).PutAssertionConditions), ctx, domainName, policyName, assertionId, auditRef, assertionConditions)
+}
+
+// PutAssertionPolicyVersion mocks base method.
+func (m *MockZmsClient) PutAssertionPolicyVersion(ctx context.Context, domainName, policyName, version, auditRef string, assertion *zms.Assertion) (*zms.Assertion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutAssertionPolicyVersion", ctx, domainName, policyName, version, auditRef, assertion)
+	ret0, _ := ret[0].(*zms.Assertion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutAssertionPolicyVersion indicates an expected call of PutAssertionPolicyVersion.
+func (mr *MockZmsClientMockRecorder) PutAssertionPolicyVersion(ctx, domainName, policyName, version, auditRef, assertion interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutAssertionPolicyVersion", reflect.TypeOf((*MockZmsClient)(nil).PutAssertionPolicyVersion), ctx, domainName, policyName, version, auditRef, assertion)
+}
+
+// PutDomainDependency mocks base method.
+func (m *MockZmsClient) PutDomainDependency(ctx context.Context, domain, service, auditRef string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutDomainDependency", ctx, domain, service, auditRef)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutDomainDependency indicates an expected call of PutDomainDependency.
+func (mr *MockZmsClientMockRecorder) PutDomainDependency(ctx, domain, service, auditRef interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutDomainDependency", reflect.TypeOf((*MockZmsClient)(nil).PutDomainDependency), ctx, domain, service, auditRef)
+}
+
+// PutDomainMeta mocks base method.
+func (m *MockZmsClient) PutDomainMeta(ctx context.Context, name, auditRef string, detail *zms.DomainMeta) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutDomainMeta", ctx, name, auditRef, detail)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutDomainMeta indicates an expected call of PutDomainMeta.
+func (mr *MockZmsClientMockRecorder) PutDomainMeta(ctx, name, auditRef, detail interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutDomainMeta", reflect.TypeOf((*MockZmsClient)(nil).PutDomainMeta), ctx, name, auditRef, detail)
+}
+
+// PutDomainTemplate mocks base method.
+func (m *MockZmsClient) PutDomainTemplate(ctx context.Context, domainName, templateName, auditRef string, template *zms.DomainTemplate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutDomainTemplate", ctx, domainName, templateName, auditRef, template)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutDomainTemplate indicates an expected call of PutDomainTemplate.
+func (mr *MockZmsClientMockRecorder) PutDomainTemplate(ctx, domainName, templateName, auditRef, template interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutDomainTemplate", reflect.TypeOf((*MockZmsClient)(nil).PutDomainTemplate), ctx, domainName, templateName, auditRef, template)
+}
+
+// PutEntity mocks base method.
+func (m *MockZmsClient) PutEntity(ctx context.Context, domainName, entityName, auditRef string, entity *zms.Entity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutEntity", ctx, domainName, entityName, auditRef, entity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutEntity indicates an expected call of PutEntity.
+func (mr *MockZmsClientMockRecorder) PutEntity(ctx, domainName, entityName, auditRef, entity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutEntity", reflect.TypeOf((*MockZmsClient)(nil).PutEntity), ctx, domainName, entityName, auditRef, entity)
+}
+
+// PutGroup mocks base method.
+func (m *MockZmsClient) PutGroup(ctx context.Context, domain, groupName, auditRef string, group *zms.Group) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutGroup", ctx, domain, groupName, auditRef, group)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutGroup indicates an expected call of PutGroup.
+func (mr *MockZmsClientMockRecorder) PutGroup(ctx, domain, groupName, auditRef, group interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutGroup", reflect.TypeOf((*MockZmsClient)(nil).PutGroup), ctx, domain, groupName, auditRef, group)
+}
+
+// PutGroupMembership mocks base method.
+func (m *MockZmsClient) PutGroupMembership(ctx context.Context, domain, groupName string, memberName zms.GroupMemberName, auditRef string, membership *zms.GroupMembership) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutGroupMembership", ctx, domain, groupName, memberName, auditRef, membership)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutGroupMembership indicates an expected call of PutGroupMembership.
+func (mr *MockZmsClientMockRecorder) PutGroupMembership(ctx, domain, groupName, memberName, auditRef, membership interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutGroupMembership", reflect.TypeOf((*MockZmsClient)(nil).PutGroupMembership), ctx, domain, groupName, memberName, auditRef, membership)
+}
+
+// PutGroupMeta mocks base method.
+func (m *MockZmsClient) PutGroupMeta(ctx context.Context, domain, groupName, auditRef string, group *zms.GroupMeta) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutGroupMeta", ctx, domain, groupName, auditRef, group)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutGroupMeta indicates an expected call of PutGroupMeta.
+func (mr *MockZmsClientMockRecorder) PutGroupMeta(ctx, domain, groupName, auditRef, group interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutGroupMeta", reflect.TypeOf((*MockZmsClient)(nil).PutGroupMeta), ctx, domain, groupName, auditRef, group)
+}
+
+// PutMembership mocks base method.
+func (m *MockZmsClient) PutMembership(ctx context.Context, domain, roleName string, memberName zms.MemberName, auditRef string, membership *zms.Membership) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutMembership", ctx, domain, roleName, memberName, auditRef, membership)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutMembership indicates an expected call of PutMembership.
+func (mr *MockZmsClientMockRecorder) PutMembership(ctx, domain, roleName, memberName, auditRef, membership interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutMembership", reflect.TypeOf((*MockZmsClient)(nil).PutMembership), ctx, domain, roleName, memberName, auditRef, membership)
+}
+
+// PutMembershipDecision mocks base method.
+func (m *MockZmsClient) PutMembershipDecision(ctx context.Context, domain, roleName string, memberName zms.MemberName, auditRef string, membership *zms.Membership) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutMembershipDecision", ctx, domain, roleName, memberName, auditRef, membership)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutMembershipDecision indicates an expected call of PutMembershipDecision.
+func (mr *MockZmsClientMockRecorder) PutMembershipDecision(ctx, domain, roleName, memberName, auditRef, membership interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutMembershipDecision", reflect.TypeOf((*MockZmsClient)(nil).PutMembershipDecision), ctx, domain, roleName, memberName, auditRef, membership)
+}
+
+// PutPolicy mocks base method.
+func (m *MockZmsClient) PutPolicy(ctx context.Context, domain, policyName, auditRef string, policy *zms.Policy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutPolicy", ctx, domain, policyName, auditRef, policy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutPolicy indicates an expected call of PutPolicy.
+func (mr *MockZmsClientMockRecorder) PutPolicy(ctx, domain, policyName, auditRef, policy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutPolicy", reflect.TypeOf((*MockZmsClient)(nil).PutPolicy), ctx, domain, policyName, auditRef, policy)
+}
+
+// PutPolicyVersion mocks base method.
+func (m *MockZmsClient) PutPolicyVersion(ctx context.Context, domainName, policyName string, policyOptions *zms.PolicyOptions, auditRef string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutPolicyVersion", ctx, domainName, policyName, policyOptions, auditRef)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutPolicyVersion indicates an expected call of PutPolicyVersion.
+func (mr *MockZmsClientMockRecorder) PutPolicyVersion(ctx, domainName, policyName, policyOptions, auditRef interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutPolicyVersion", reflect.TypeOf((*MockZmsClient)(nil).PutPolicyVersion), ctx, domainName, policyName, policyOptions, auditRef)
+}
+
+// PutProviderResourceGroupRoles mocks base method.
+func (m *MockZmsClient) PutProviderResourceGroupRoles(ctx context.Context, domain, providerDomain, providerService, resourceGroup, auditRef string, roles *zms.ProviderResourceGroupRoles) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutProviderResourceGroupRoles", ctx, domain, providerDomain, providerService, resourceGroup, auditRef, roles)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutProviderResourceGroupRoles indicates an expected call of PutProviderResourceGroupRoles.
+func (mr *MockZmsClientMockRecorder) PutProviderResourceGroupRoles(ctx, domain, providerDomain, providerService, resourceGroup, auditRef, roles interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutProviderResourceGroupRoles", reflect.TypeOf((*MockZmsClient)(nil).PutProviderResourceGroupRoles), ctx, domain, providerDomain, providerService, resourceGroup, auditRef, roles)
+}
+
+// PutPublicKeyEntry mocks base method.
+func (m *MockZmsClient) PutPublicKeyEntry(ctx context.Context, domain, serviceName, keyId, auditRef string, publicKeyEntry *zms.PublicKeyEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutPublicKeyEntry", ctx, domain, serviceName, keyId, auditRef, publicKeyEntry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutPublicKeyEntry indicates an expected call of PutPublicKeyEntry.
+func (mr *MockZmsClientMockRecorder) PutPublicKeyEntry(ctx, domain, serviceName, keyId, auditRef, publicKeyEntry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutPublicKeyEntry", reflect.TypeOf((*MockZmsClient)(nil).PutPublicKeyEntry), ctx, domain, serviceName, keyId, auditRef, publicKeyEntry)
+}
+
+// PutQuota mocks base method.
+func (m *MockZmsClient) PutQuota(ctx context.Context, domainName, auditRef string, quota *zms.Quota) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutQuota", ctx, domainName, auditRef, quota)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutQuota indicates an expected call of PutQuota.
+func (mr *MockZmsClientMockRecorder) PutQuota(ctx, domainName, auditRef, quota interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutQuota", reflect.TypeOf((*MockZmsClient)(nil).PutQuota), ctx, domainName, auditRef, quota)
+}
+
+// PutRole mocks base method.
+func (m *MockZmsClient) PutRole(ctx context.Context, domain, roleName, auditRef string, role *zms.Role) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutRole", ctx, domain, roleName, auditRef, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutRole indicates an expected call of PutRole.
+func (mr *MockZmsClientMockRecorder) PutRole(ctx, domain, roleName, auditRef, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutRole", reflect.TypeOf((*MockZmsClient)(nil).PutRole), ctx, domain, roleName, auditRef, role)
+}
+
+// PutRoleMeta mocks base method.
+func (m *MockZmsClient) PutRoleMeta(ctx context.Context, domain, roleName, auditRef string, group *zms.RoleMeta) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutRoleMeta", ctx, domain, roleName, auditRef, group)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutRoleMeta indicates an expected call of PutRoleMeta.
+func (mr *MockZmsClientMockRecorder) PutRoleMeta(ctx, domain, roleName, auditRef, group interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutRoleMeta", reflect.TypeOf((*MockZmsClient)(nil).PutRoleMeta), ctx, domain, roleName, auditRef, group)
+}
+
+// PutRoleReview mocks base method.
+func (m *MockZmsClient) PutRoleReview(ctx context.Context, domain, roleName, auditRef string, role *zms.Role) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutRoleReview", ctx, domain, roleName, auditRef, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutRoleReview indicates an expected call of PutRoleReview.
+func (mr *MockZmsClientMockRecorder) PutRoleReview(ctx, domain, roleName, auditRef, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutRoleReview", reflect.TypeOf((*MockZmsClient)(nil).PutRoleReview), ctx, domain, roleName, auditRef, role)
+}
+
+// PutServiceCredsEntry mocks base method.
+func (m *MockZmsClient) PutServiceCredsEntry(ctx context.Context, domain, serviceName, auditRef string, creds *zms.CredsEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutServiceCredsEntry", ctx, domain, serviceName, auditRef, creds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutServiceCredsEntry indicates an expected call of PutServiceCredsEntry.
+func (mr *MockZmsClientMockRecorder) PutServiceCredsEntry(ctx, domain, serviceName, auditRef, creds interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutServiceCredsEntry", reflect.TypeOf((*MockZmsClient)(nil).PutServiceCredsEntry), ctx, domain, serviceName, auditRef, creds)
+}
+
+// PutServiceIdentity mocks base method.
+func (m *MockZmsClient) PutServiceIdentity(ctx context.Context, domain, serviceName, auditRef string, detail *zms.ServiceIdentity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutServiceIdentity", ctx, domain, serviceName, auditRef, detail)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutServiceIdentity indicates an expected call of PutServiceIdentity.
+func (mr *MockZmsClientMockRecorder) PutServiceIdentity(ctx, domain, serviceName, auditRef, detail interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutServiceIdentity", reflect.TypeOf((*MockZmsClient)(nil).PutServiceIdentity), ctx, domain, serviceName, auditRef, detail)
+}
+
+// PutServiceIdentitySystemMeta mocks base method.
+func (m *MockZmsClient) PutServiceIdentitySystemMeta(ctx context.Context, domain, serviceName, attribute, auditRef string, meta *zms.ServiceIdentitySystemMeta) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutServiceIdentitySystemMeta", ctx, domain, serviceName, attribute, auditRef, meta)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutServiceIdentitySystemMeta indicates an expected call of PutServiceIdentitySystemMeta.
+func (mr *MockZmsClientMockRecorder) PutServiceIdentitySystemMeta(ctx, domain, serviceName, attribute, auditRef, meta interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutServiceIdentitySystemMeta", reflect.TypeOf((*MockZmsClient)(nil).PutServiceIdentitySystemMeta), ctx, domain, serviceName, attribute, auditRef, meta)
+}
+
+// PutTenancy mocks base method.
+func (m *MockZmsClient) PutTenancy(ctx context.Context, domain, providerService, auditRef string, tenancy *zms.Tenancy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutTenancy", ctx, domain, providerService, auditRef, tenancy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutTenancy indicates an expected call of PutTenancy.
+func (mr *MockZmsClientMockRecorder) PutTenancy(ctx, domain, providerService, auditRef, tenancy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutTenancy", reflect.TypeOf((*MockZmsClient)(nil).PutTenancy), ctx, domain, providerService, auditRef, tenancy)
+}
+
+// SetActivePolicyVersion mocks base method.
+func (m *MockZmsClient) SetActivePolicyVersion(ctx context.Context, domainName, policyName string, policyOptions *zms.PolicyOptions, auditRef string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetActivePolicyVersion", ctx, domainName, policyName, policyOptions, auditRef)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetActivePolicyVersion indicates an expected call of SetActivePolicyVersion.
+func (mr *MockZmsClientMockRecorder) SetActivePolicyVersion(ctx, domainName, policyName, policyOptions, auditRef interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutRole", reflect.TypeOf((*MockZmsClient)(nil).PutRole), domain, roleName, auditRef, role)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetActivePolicyVersion", reflect.TypeOf((*MockZmsClient)(nil).SetActivePolicyVersion), ctx, domainName, policyName, policyOptions, auditRef)
 }
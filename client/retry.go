@@ -0,0 +1,201 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/AthenZ/athenz/clients/go/zms"
+	"github.com/ardielle/ardielle-go/rdl"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	DefaultMaxRetries   = 3
+	DefaultRetryMaxWait = 30 * time.Second
+
+	retryBaseDelay = time.Second
+)
+
+// DefaultRetryOnStatus lists the status codes retried when the provider
+// configuration does not override retry_on_status.
+var DefaultRetryOnStatus = []int{ErrCodeRateLimit, 502, 503, 504}
+
+// retryPolicy controls how failed ZMS calls are retried. Rate-limit responses
+// are rejected by the server before any processing takes place, so they are
+// retried for every method. Other retryable statuses and connection resets
+// are only retried for idempotent reads.
+type retryPolicy struct {
+	maxRetries    int
+	maxWait       time.Duration
+	retryOnStatus map[int]bool
+}
+
+func newRetryPolicy(maxRetries int, maxWait time.Duration, retryOnStatus []int) retryPolicy {
+	if maxRetries < 0 {
+		maxRetries = DefaultMaxRetries
+	}
+	if maxWait <= 0 {
+		maxWait = DefaultRetryMaxWait
+	}
+	if len(retryOnStatus) == 0 {
+		retryOnStatus = DefaultRetryOnStatus
+	}
+	statuses := make(map[int]bool, len(retryOnStatus))
+	for _, status := range retryOnStatus {
+		statuses[status] = true
+	}
+	return retryPolicy{
+		maxRetries:    maxRetries,
+		maxWait:       maxWait,
+		retryOnStatus: statuses,
+	}
+}
+
+// shouldRetry reports whether err is worth another attempt and a short
+// description of why, used in the retry log message.
+func (p retryPolicy) shouldRetry(err error, idempotent bool) (bool, string) {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false, ""
+	}
+	if errObj, ok := err.(rdl.ResourceError); ok {
+		if !p.retryOnStatus[errObj.Code] {
+			return false, ""
+		}
+		if errObj.Code == ErrCodeRateLimit || idempotent {
+			return true, fmt.Sprintf("status %d", errObj.Code)
+		}
+		return false, ""
+	}
+	if idempotent && isConnectionReset(err) {
+		return true, "connection reset"
+	}
+	return false, ""
+}
+
+// backoff returns the delay before the given retry (starting at 1). A
+// Retry-After value sent by the server takes precedence over the
+// exponential schedule; both are capped at maxWait.
+func (p retryPolicy) backoff(retry int, retryAfter time.Duration) time.Duration {
+	if retryAfter > 0 {
+		if retryAfter > p.maxWait {
+			return p.maxWait
+		}
+		return retryAfter
+	}
+	delay := p.maxWait
+	if shift := retry - 1; shift < 32 {
+		if d := retryBaseDelay << uint(shift); d > 0 && d < p.maxWait {
+			delay = d
+		}
+	}
+	// equal jitter: keep half of the delay and randomize the other half so
+	// that parallel resources do not retry in lock step
+	half := delay / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+func isConnectionReset(err error) bool {
+	return errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNABORTED) ||
+		errors.Is(err, syscall.EPIPE) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, io.EOF)
+}
+
+// parseRetryAfter understands both forms of the Retry-After header: a number
+// of seconds or an HTTP date.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		if delay := date.Sub(now); delay > 0 {
+			return delay
+		}
+	}
+	return 0
+}
+
+// retry runs call until it succeeds, fails with a non-retryable error, the
+// retry budget is exhausted or ctx is done. Each attempt gets its own copy of
+// the ZMS client so the Retry-After header of the last response can be
+// inspected, and may fail over to the other configured endpoints before it
+// counts as failed.
+func (c Client) retry(ctx context.Context, method string, idempotent bool, call func(zmsClient zms.ZMSClient) error) error {
+	policy := c.retryPolicy
+	if policy.retryOnStatus == nil {
+		policy = newRetryPolicy(DefaultMaxRetries, DefaultRetryMaxWait, nil)
+	}
+	ctx = tflog.SetField(ctx, "zms_method", method)
+	var err error
+	for attempt := 1; ; attempt++ {
+		attemptCtx := tflog.SetField(ctx, "retries", attempt-1)
+		if c.certs.maybeReload(attemptCtx, time.Now()) {
+			// idle connections were authenticated with the previous
+			// certificate, drop them so the next request handshakes again
+			c.Transport.CloseIdleConnections()
+		}
+		var transport *contextTransport
+		transport, err = c.failover(attemptCtx, idempotent, call)
+		retryable, reason := policy.shouldRetry(err, idempotent)
+		if !retryable {
+			return err
+		}
+		if attempt > policy.maxRetries {
+			break
+		}
+		delay := policy.backoff(attempt, parseRetryAfter(transport.retryAfter, time.Now()))
+		tflog.Warn(attemptCtx, "ZMS request failed, retrying", map[string]interface{}{
+			"attempt":      attempt,
+			"max_attempts": policy.maxRetries + 1,
+			"reason":       reason,
+			"delay":        delay.String(),
+			"error":        err.Error(),
+		})
+		if err := sleep(ctx, delay); err != nil {
+			return err
+		}
+	}
+	return fmt.Errorf("%s: retried %d times but still failed: %w", method, policy.maxRetries, err)
+}
+
+// failover sends one attempt of a request to the preferred endpoint and, when
+// that endpoint is unavailable and the request allows it, to the next ones.
+func (c Client) failover(ctx context.Context, idempotent bool, call func(zmsClient zms.ZMSClient) error) (*contextTransport, error) {
+	tried := make(map[string]bool)
+	for {
+		endpoint := c.endpoints.pick(time.Now(), tried)
+		tried[endpoint] = true
+		release, err := c.limiter.acquire(ctx)
+		if err != nil {
+			return nil, err
+		}
+		endpointCtx := tflog.SetField(ctx, "zms_url", endpoint)
+		zmsClient, transport := c.newZmsClient(endpointCtx, endpoint)
+		err = call(zmsClient)
+		release()
+		if ctx.Err() != nil {
+			return transport, err
+		}
+		c.endpoints.report(endpoint, err, time.Now())
+		if !canFailover(err, idempotent) || len(tried) >= c.endpoints.size() {
+			return transport, c.endpoints.annotate(err, endpoint)
+		}
+		tflog.Warn(endpointCtx, "ZMS endpoint failed, failing over to the next one", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+}
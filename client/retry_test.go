@@ -0,0 +1,161 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/AthenZ/athenz/clients/go/zms"
+	"github.com/ardielle/ardielle-go/rdl"
+)
+
+func TestShouldRetry(t *testing.T) {
+	policy := newRetryPolicy(3, time.Second, nil)
+	tests := []struct {
+		name       string
+		err        error
+		idempotent bool
+		want       bool
+	}{
+		{name: "no error", err: nil, idempotent: true, want: false},
+		{name: "rate limited read", err: rdl.ResourceError{Code: 429}, idempotent: true, want: true},
+		{name: "rate limited write", err: rdl.ResourceError{Code: 429}, idempotent: false, want: true},
+		{name: "unavailable read", err: rdl.ResourceError{Code: 503}, idempotent: true, want: true},
+		{name: "unavailable write", err: rdl.ResourceError{Code: 503}, idempotent: false, want: false},
+		{name: "not found", err: rdl.ResourceError{Code: 404}, idempotent: true, want: false},
+		{name: "internal error", err: rdl.ResourceError{Code: 500}, idempotent: true, want: false},
+		{name: "connection reset read", err: syscall.ECONNRESET, idempotent: true, want: true},
+		{name: "connection reset write", err: syscall.ECONNRESET, idempotent: false, want: false},
+		{name: "cancelled", err: context.Canceled, idempotent: true, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, _ := policy.shouldRetry(tt.err, tt.idempotent); got != tt.want {
+				t.Errorf("shouldRetry() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	custom := newRetryPolicy(3, time.Second, []int{500})
+	if got, _ := custom.shouldRetry(rdl.ResourceError{Code: 500}, true); !got {
+		t.Errorf("shouldRetry() with custom statuses did not retry 500")
+	}
+	if got, _ := custom.shouldRetry(rdl.ResourceError{Code: 429}, true); got {
+		t.Errorf("shouldRetry() with custom statuses retried 429")
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	policy := newRetryPolicy(10, 8*time.Second, nil)
+	for retry := 1; retry <= 10; retry++ {
+		want := retryBaseDelay << uint(retry-1)
+		if want > policy.maxWait {
+			want = policy.maxWait
+		}
+		got := policy.backoff(retry, 0)
+		if got < want/2 || got > want {
+			t.Errorf("backoff(%d) = %s, want between %s and %s", retry, got, want/2, want)
+		}
+	}
+	if got := policy.backoff(1, 5*time.Second); got != 5*time.Second {
+		t.Errorf("backoff() with Retry-After = %s, want 5s", got)
+	}
+	if got := policy.backoff(1, time.Minute); got != policy.maxWait {
+		t.Errorf("backoff() with long Retry-After = %s, want %s", got, policy.maxWait)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		value string
+		want  time.Duration
+	}{
+		{value: "", want: 0},
+		{value: "7", want: 7 * time.Second},
+		{value: "-1", want: 0},
+		{value: "soon", want: 0},
+		{value: now.Add(90 * time.Second).Format(http.TimeFormat), want: 90 * time.Second},
+		{value: now.Add(-time.Minute).Format(http.TimeFormat), want: 0},
+	}
+	for _, tt := range tests {
+		if got := parseRetryAfter(tt.value, now); got != tt.want {
+			t.Errorf("parseRetryAfter(%q) = %s, want %s", tt.value, got, tt.want)
+		}
+	}
+}
+
+func newRetryTestServer(t *testing.T, statuses ...int) (*httptest.Server, *int32) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := int(atomic.AddInt32(&calls, 1))
+		if call <= len(statuses) {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(statuses[call-1])
+			_, _ = fmt.Fprintf(w, `{"code":%d,"message":"%s"}`, statuses[call-1], http.StatusText(statuses[call-1]))
+			return
+		}
+		if r.Method == http.MethodGet {
+			_, _ = w.Write([]byte(`{"name":"sys.auth"}`))
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(server.Close)
+	return server, &calls
+}
+
+func TestRetryReadOnServerError(t *testing.T) {
+	server, calls := newRetryTestServer(t, 503, 502)
+	c := Client{Url: server.URL, Transport: &http.Transport{}, retryPolicy: newRetryPolicy(3, 10*time.Millisecond, nil)}
+	domain, err := c.GetDomain(context.Background(), "sys.auth")
+	if err != nil {
+		t.Fatalf("GetDomain() error = %v", err)
+	}
+	if domain.Name != "sys.auth" {
+		t.Errorf("GetDomain() name = %s, want sys.auth", domain.Name)
+	}
+	if got := atomic.LoadInt32(calls); got != 3 {
+		t.Errorf("server received %d calls, want 3", got)
+	}
+}
+
+func TestRetryDoesNotRepeatWriteOnServerError(t *testing.T) {
+	server, calls := newRetryTestServer(t, 503)
+	c := Client{Url: server.URL, Transport: &http.Transport{}, retryPolicy: newRetryPolicy(3, 10*time.Millisecond, nil)}
+	err := c.PutRole(context.Background(), "sys.auth", "readers", "audit", &zms.Role{Name: "sys.auth:role.readers"})
+	if errObj, ok := err.(rdl.ResourceError); !ok || errObj.Code != 503 {
+		t.Fatalf("PutRole() error = %v, want status 503", err)
+	}
+	if got := atomic.LoadInt32(calls); got != 1 {
+		t.Errorf("server received %d calls, want 1", got)
+	}
+}
+
+func TestRetryWriteOnRateLimit(t *testing.T) {
+	server, calls := newRetryTestServer(t, 429)
+	c := Client{Url: server.URL, Transport: &http.Transport{}, retryPolicy: newRetryPolicy(3, 10*time.Millisecond, nil)}
+	if err := c.DeleteRole(context.Background(), "sys.auth", "readers", "audit"); err != nil {
+		t.Fatalf("DeleteRole() error = %v", err)
+	}
+	if got := atomic.LoadInt32(calls); got != 2 {
+		t.Errorf("server received %d calls, want 2", got)
+	}
+}
+
+func TestRetryGivesUpAfterMaxRetries(t *testing.T) {
+	server, calls := newRetryTestServer(t, 429, 429, 429, 429)
+	c := Client{Url: server.URL, Transport: &http.Transport{}, retryPolicy: newRetryPolicy(2, 10*time.Millisecond, nil)}
+	_, err := c.GetDomain(context.Background(), "sys.auth")
+	if err == nil {
+		t.Fatal("GetDomain() succeeded, want error")
+	}
+	if got := atomic.LoadInt32(calls); got != 3 {
+		t.Errorf("server received %d calls, want 3", got)
+	}
+}
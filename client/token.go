@@ -0,0 +1,217 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	AuthModeMTLS  = "mtls"
+	AuthModeToken = "token"
+
+	grantTypeClientCredentials = "client_credentials"
+	grantTypeTokenExchange     = "urn:ietf:params:oauth:grant-type:token-exchange"
+	tokenTypeIdToken           = "urn:ietf:params:oauth:token-type:id_token"
+	tokenTypeAccessToken       = "urn:ietf:params:oauth:token-type:access_token"
+
+	// tokenRefreshWindow is how long before expiry a cached access token is
+	// replaced, so that a request never leaves with a token about to expire.
+	tokenRefreshWindow = time.Minute
+	// defaultTokenLifetime is assumed when ZTS does not return expires_in.
+	defaultTokenLifetime = time.Hour
+)
+
+// tokenSource returns the access token sent as Bearer credentials to ZMS.
+type tokenSource interface {
+	token(ctx context.Context) (string, error)
+}
+
+// bearerTransport adds the access token of its source to every request.
+type bearerTransport struct {
+	source    tokenSource
+	transport http.RoundTripper
+}
+
+func (t *bearerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.source.token(req.Context())
+	if err != nil {
+		return nil, fmt.Errorf("unable to obtain access token, error: %v", err)
+	}
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+token)
+	return t.transport.RoundTrip(req)
+}
+
+// staticTokenSource always returns the configured token.
+type staticTokenSource string
+
+func (s staticTokenSource) token(context.Context) (string, error) {
+	return string(s), nil
+}
+
+// fileTokenSource reads the token from a file, re-reading it whenever the
+// file changes so that tokens refreshed by an agent on disk are picked up.
+type fileTokenSource struct {
+	path    string
+	mu      sync.Mutex
+	value   string
+	modTime time.Time
+}
+
+func (s *fileTokenSource) token(context.Context) (string, error) {
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return "", err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.value != "" && info.ModTime().Equal(s.modTime) {
+		return s.value, nil
+	}
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return "", err
+	}
+	value := strings.TrimSpace(string(data))
+	if value == "" {
+		return "", fmt.Errorf("token file %s is empty", s.path)
+	}
+	s.value = value
+	s.modTime = info.ModTime()
+	return s.value, nil
+}
+
+// ztsTokenSource obtains access tokens from the ZTS oauth2 token endpoint and
+// caches them until shortly before they expire. When subjectToken is set the
+// given OIDC id token is exchanged for an access token, otherwise the client
+// authenticates with its certificate and uses the client credentials grant.
+type ztsTokenSource struct {
+	tokenUrl     string
+	scope        string
+	subjectToken tokenSource
+	httpClient   *http.Client
+
+	mu        sync.Mutex
+	value     string
+	expiresAt time.Time
+}
+
+type ztsTokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+func (s *ztsTokenSource) token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.value != "" && time.Until(s.expiresAt) > tokenRefreshWindow {
+		return s.value, nil
+	}
+	form := url.Values{}
+	form.Set("scope", s.scope)
+	if s.subjectToken != nil {
+		subjectToken, err := s.subjectToken.token(ctx)
+		if err != nil {
+			return "", fmt.Errorf("unable to read oidc token, error: %v", err)
+		}
+		form.Set("grant_type", grantTypeTokenExchange)
+		form.Set("subject_token", subjectToken)
+		form.Set("subject_token_type", tokenTypeIdToken)
+		form.Set("requested_token_type", tokenTypeAccessToken)
+	} else {
+		form.Set("grant_type", grantTypeClientCredentials)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.tokenUrl, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("zts token request failed with status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	var tokenResponse ztsTokenResponse
+	if err = json.Unmarshal(body, &tokenResponse); err != nil {
+		return "", fmt.Errorf("unable to parse zts token response, error: %v", err)
+	}
+	if tokenResponse.AccessToken == "" {
+		return "", fmt.Errorf("zts token response did not include an access token")
+	}
+	lifetime := defaultTokenLifetime
+	if tokenResponse.ExpiresIn > 0 {
+		lifetime = time.Duration(tokenResponse.ExpiresIn) * time.Second
+	}
+	s.value = tokenResponse.AccessToken
+	s.expiresAt = time.Now().Add(lifetime)
+	return s.value, nil
+}
+
+// newTokenSource returns the access token source configured for the token
+// auth mode. Exactly one of a static token, a token file or a ZTS url must be
+// configured. Fetching tokens from ZTS without an OIDC token authenticates
+// to ZTS with the configured client certificate.
+func newTokenSource(zmsConfig *ZmsConfig) (tokenSource, error) {
+	configured := 0
+	for _, value := range []string{zmsConfig.AccessToken, zmsConfig.AccessTokenFile, zmsConfig.ZtsUrl} {
+		if value != "" {
+			configured++
+		}
+	}
+	if configured != 1 {
+		return nil, fmt.Errorf("token auth mode requires exactly one of access_token, access_token_file or zts_url")
+	}
+	switch {
+	case zmsConfig.AccessToken != "":
+		return staticTokenSource(zmsConfig.AccessToken), nil
+	case zmsConfig.AccessTokenFile != "":
+		return &fileTokenSource{path: zmsConfig.AccessTokenFile}, nil
+	}
+	if zmsConfig.AccessTokenScope == "" {
+		return nil, fmt.Errorf("access_token_scope is required when access tokens are obtained from zts_url")
+	}
+	source := &ztsTokenSource{
+		tokenUrl: strings.TrimSuffix(zmsConfig.ZtsUrl, "/") + "/oauth2/token",
+		scope:    zmsConfig.AccessTokenScope,
+	}
+	var (
+		tlsConfig *tls.Config
+		err       error
+	)
+	switch {
+	case zmsConfig.OidcToken != "":
+		source.subjectToken = staticTokenSource(zmsConfig.OidcToken)
+	case zmsConfig.OidcTokenFile != "":
+		source.subjectToken = &fileTokenSource{path: zmsConfig.OidcTokenFile}
+	}
+	if source.subjectToken != nil {
+		tlsConfig, err = newServerTLSConfig(zmsConfig)
+	} else {
+		tlsConfig, _, err = newTLSConfig(zmsConfig)
+	}
+	if err != nil {
+		return nil, err
+	}
+	source.httpClient = &http.Client{
+		Transport: newTransport(tlsConfig, zmsConfig),
+		Timeout:   zmsConfig.RequestTimeout,
+	}
+	return source, nil
+}
@@ -0,0 +1,204 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newBearerTestServer starts a ZMS stand-in that rejects requests without a
+// Bearer token and otherwise answers with a domain named after the token.
+func newBearerTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var token string
+		if _, err := fmt.Sscanf(r.Header.Get("Authorization"), "Bearer %s", &token); err != nil {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"code":401,"message":"missing token"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"name":"` + token + `"}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// newTokenTestServer starts a fake ZTS token endpoint that hands out numbered
+// tokens and records the grant type of the last request.
+func newTokenTestServer(t *testing.T, expiresIn int) (*httptest.Server, *int32, *atomic.Value) {
+	t.Helper()
+	var issued int32
+	var lastGrant atomic.Value
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/zts/v1/oauth2/token" || r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if err := r.ParseForm(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		lastGrant.Store(r.PostForm.Get("grant_type"))
+		if r.PostForm.Get("grant_type") == grantTypeTokenExchange && r.PostForm.Get("subject_token") != "oidc-token" {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"error":"invalid subject token"}`))
+			return
+		}
+		count := atomic.AddInt32(&issued, 1)
+		_, _ = fmt.Fprintf(w, `{"access_token":"token-%d","token_type":"Bearer","expires_in":%d}`, count, expiresIn)
+	}))
+	t.Cleanup(server.Close)
+	return server, &issued, &lastGrant
+}
+
+func TestClientWithStaticAccessToken(t *testing.T) {
+	zmsServer := newBearerTestServer(t)
+	c, err := NewClient(&ZmsConfig{Url: zmsServer.URL, AuthMode: AuthModeToken, AccessToken: "static-token"})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	domain, err := c.GetDomain(context.Background(), "sys.auth")
+	if err != nil {
+		t.Fatalf("GetDomain() error = %v", err)
+	}
+	if domain.Name != "static-token" {
+		t.Fatalf("server saw token %s, want static-token", domain.Name)
+	}
+}
+
+func TestClientWithAccessTokenFile(t *testing.T) {
+	zmsServer := newBearerTestServer(t)
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("file-token-1\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	c, err := NewClient(&ZmsConfig{Url: zmsServer.URL, AuthMode: AuthModeToken, AccessTokenFile: tokenFile})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	domain, err := c.GetDomain(context.Background(), "sys.auth")
+	if err != nil {
+		t.Fatalf("GetDomain() error = %v", err)
+	}
+	if domain.Name != "file-token-1" {
+		t.Fatalf("server saw token %s, want file-token-1", domain.Name)
+	}
+
+	if err := os.WriteFile(tokenFile, []byte("file-token-2"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	later := time.Now().Add(time.Minute)
+	if err := os.Chtimes(tokenFile, later, later); err != nil {
+		t.Fatal(err)
+	}
+	domain, err = c.GetDomain(context.Background(), "sys.auth")
+	if err != nil {
+		t.Fatalf("GetDomain() error = %v", err)
+	}
+	if domain.Name != "file-token-2" {
+		t.Fatalf("server saw token %s after refresh, want file-token-2", domain.Name)
+	}
+}
+
+func TestClientWithOidcTokenExchange(t *testing.T) {
+	zmsServer := newBearerTestServer(t)
+	ztsServer, issued, lastGrant := newTokenTestServer(t, 3600)
+	c, err := NewClient(&ZmsConfig{
+		Url:              zmsServer.URL,
+		AuthMode:         AuthModeToken,
+		ZtsUrl:           ztsServer.URL + "/zts/v1",
+		AccessTokenScope: "sys.auth:domain",
+		OidcToken:        "oidc-token",
+	})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	for i := 0; i < 3; i++ {
+		domain, err := c.GetDomain(context.Background(), "sys.auth")
+		if err != nil {
+			t.Fatalf("GetDomain() error = %v", err)
+		}
+		if domain.Name != "token-1" {
+			t.Fatalf("server saw token %s, want token-1", domain.Name)
+		}
+	}
+	if got := atomic.LoadInt32(issued); got != 1 {
+		t.Errorf("zts issued %d tokens, want 1", got)
+	}
+	if got := lastGrant.Load(); got != grantTypeTokenExchange {
+		t.Errorf("zts saw grant type %v, want %s", got, grantTypeTokenExchange)
+	}
+}
+
+func TestClientRefreshesExpiringAccessToken(t *testing.T) {
+	zmsServer := newBearerTestServer(t)
+	ztsServer, issued, _ := newTokenTestServer(t, 30)
+	c, err := NewClient(&ZmsConfig{
+		Url:              zmsServer.URL,
+		AuthMode:         AuthModeToken,
+		ZtsUrl:           ztsServer.URL + "/zts/v1",
+		AccessTokenScope: "sys.auth:domain",
+		OidcToken:        "oidc-token",
+	})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	// tokens valid for less than the refresh window are replaced every time
+	for i := 1; i <= 2; i++ {
+		domain, err := c.GetDomain(context.Background(), "sys.auth")
+		if err != nil {
+			t.Fatalf("GetDomain() error = %v", err)
+		}
+		if want := fmt.Sprintf("token-%d", i); string(domain.Name) != want {
+			t.Fatalf("server saw token %s, want %s", domain.Name, want)
+		}
+	}
+	if got := atomic.LoadInt32(issued); got != 2 {
+		t.Errorf("zts issued %d tokens, want 2", got)
+	}
+}
+
+func TestClientReportsTokenExchangeFailure(t *testing.T) {
+	zmsServer := newBearerTestServer(t)
+	ztsServer, _, _ := newTokenTestServer(t, 3600)
+	c, err := NewClient(&ZmsConfig{
+		Url:              zmsServer.URL,
+		AuthMode:         AuthModeToken,
+		ZtsUrl:           ztsServer.URL + "/zts/v1",
+		AccessTokenScope: "sys.auth:domain",
+		OidcToken:        "wrong-token",
+	})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	if _, err := c.GetDomain(context.Background(), "sys.auth"); err == nil {
+		t.Fatal("GetDomain() succeeded with a rejected oidc token")
+	}
+}
+
+func TestNewTokenSourceValidation(t *testing.T) {
+	tests := []struct {
+		name   string
+		config ZmsConfig
+	}{
+		{name: "no token input", config: ZmsConfig{}},
+		{name: "token and file", config: ZmsConfig{AccessToken: "token", AccessTokenFile: "/tmp/token"}},
+		{name: "zts without scope", config: ZmsConfig{ZtsUrl: "https://zts/zts/v1", OidcToken: "oidc"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := newTokenSource(&tt.config); err == nil {
+				t.Errorf("newTokenSource() accepted %s", tt.name)
+			}
+		})
+	}
+	if _, err := NewClient(&ZmsConfig{AuthMode: "password"}); err == nil {
+		t.Errorf("NewClient() accepted an unsupported auth mode")
+	}
+}
@@ -0,0 +1,125 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/AthenZ/athenz/clients/go/zms"
+	"golang.org/x/net/http/httpproxy"
+)
+
+const (
+	DefaultConnectionPoolSize  = 10
+	DefaultTLSHandshakeTimeout = 10 * time.Second
+
+	dialTimeout     = 30 * time.Second
+	keepAlive       = 30 * time.Second
+	idleConnTimeout = 90 * time.Second
+)
+
+// newTransport builds the single HTTP transport shared by every ZMS call of a
+// provider instance. Keeping idle connections around means that plan and
+// refresh of large domains reuse established TLS sessions instead of paying
+// a handshake per request.
+func newTransport(tlsConfig *tls.Config, zmsConfig *ZmsConfig) *http.Transport {
+	poolSize := zmsConfig.ConnectionPoolSize
+	if poolSize <= 0 {
+		poolSize = DefaultConnectionPoolSize
+	}
+	handshakeTimeout := zmsConfig.TLSHandshakeTimeout
+	if handshakeTimeout <= 0 {
+		handshakeTimeout = DefaultTLSHandshakeTimeout
+	}
+	dialer := &net.Dialer{
+		Timeout:   dialTimeout,
+		KeepAlive: keepAlive,
+	}
+	return &http.Transport{
+		Proxy:                 proxyFunc(zmsConfig),
+		TLSClientConfig:       tlsConfig,
+		DialContext:           dialer.DialContext,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          poolSize,
+		MaxIdleConnsPerHost:   poolSize,
+		IdleConnTimeout:       idleConnTimeout,
+		TLSHandshakeTimeout:   handshakeTimeout,
+		ExpectContinueTimeout: time.Second,
+	}
+}
+
+// proxyFunc selects the proxy for a request. The standard HTTP_PROXY,
+// HTTPS_PROXY and NO_PROXY environment variables apply unless the provider
+// configuration overrides them.
+func proxyFunc(zmsConfig *ZmsConfig) func(*http.Request) (*url.URL, error) {
+	config := httpproxy.FromEnvironment()
+	if zmsConfig.ProxyUrl != "" {
+		config.HTTPProxy = zmsConfig.ProxyUrl
+		config.HTTPSProxy = zmsConfig.ProxyUrl
+	}
+	if zmsConfig.NoProxy != "" {
+		config.NoProxy = zmsConfig.NoProxy
+	}
+	proxy := config.ProxyFunc()
+	return func(req *http.Request) (*url.URL, error) {
+		return proxy(req.URL)
+	}
+}
+
+// headerTransport sets the User-Agent and the configured extra headers on
+// every request.
+type headerTransport struct {
+	userAgent string
+	headers   map[string]string
+	transport http.RoundTripper
+}
+
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	if t.userAgent != "" {
+		req.Header.Set("User-Agent", t.userAgent)
+	}
+	for name, value := range t.headers {
+		req.Header.Set(name, value)
+	}
+	return t.transport.RoundTrip(req)
+}
+
+// newZmsClient returns a copy of the long-lived ZMS client whose requests are
+// bound to ctx and sent to endpoint, or to the configured url if endpoint is
+// empty. Only the transport wrapper is per call, the underlying connection
+// pool is shared.
+func (c Client) newZmsClient(ctx context.Context, endpoint string) (zms.ZMSClient, *contextTransport) {
+	zmsClient := c.zmsClient
+	if zmsClient.Transport == nil {
+		zmsClient = zms.NewClient(c.Url, c.Transport)
+	}
+	if endpoint != "" {
+		zmsClient.URL = endpoint
+	}
+	transport := &contextTransport{ctx: ctx, transport: zmsClient.Transport}
+	zmsClient.Transport = transport
+	return zmsClient, transport
+}
+
+// contextTransport attaches a context to every request it sends. The generated
+// zms client builds its requests without a context, so this is the only way
+// to propagate Terraform cancellation down to the HTTP layer. It also logs
+// every exchange and keeps the Retry-After header of the last response for
+// the retry engine.
+type contextTransport struct {
+	ctx        context.Context
+	transport  http.RoundTripper
+	retryAfter string
+}
+
+func (t *contextTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := logRoundTrip(t.ctx, t.transport, req.WithContext(t.ctx))
+	if resp != nil {
+		t.retryAfter = resp.Header.Get("Retry-After")
+	}
+	return resp, err
+}
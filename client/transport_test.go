@@ -0,0 +1,188 @@
+package client
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/AthenZ/athenz/clients/go/zms"
+)
+
+func TestNewTransport(t *testing.T) {
+	transport := newTransport(nil, &ZmsConfig{})
+	if transport.MaxIdleConnsPerHost != DefaultConnectionPoolSize {
+		t.Errorf("MaxIdleConnsPerHost = %d, want %d", transport.MaxIdleConnsPerHost, DefaultConnectionPoolSize)
+	}
+	if transport.TLSHandshakeTimeout != DefaultTLSHandshakeTimeout {
+		t.Errorf("TLSHandshakeTimeout = %s, want %s", transport.TLSHandshakeTimeout, DefaultTLSHandshakeTimeout)
+	}
+	if !transport.ForceAttemptHTTP2 {
+		t.Errorf("ForceAttemptHTTP2 = false, want true")
+	}
+
+	transport = newTransport(nil, &ZmsConfig{ConnectionPoolSize: 25, TLSHandshakeTimeout: 3 * time.Second})
+	if transport.MaxIdleConns != 25 || transport.MaxIdleConnsPerHost != 25 {
+		t.Errorf("MaxIdleConns = %d, MaxIdleConnsPerHost = %d, want 25", transport.MaxIdleConns, transport.MaxIdleConnsPerHost)
+	}
+	if transport.TLSHandshakeTimeout != 3*time.Second {
+		t.Errorf("TLSHandshakeTimeout = %s, want 3s", transport.TLSHandshakeTimeout)
+	}
+}
+
+func TestClientReusesConnections(t *testing.T) {
+	var connections int32
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"name":"sys.auth"}`))
+	}))
+	server.Config.ConnState = func(conn net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			atomic.AddInt32(&connections, 1)
+		}
+	}
+	server.Start()
+	defer server.Close()
+
+	transport := newTransport(nil, &ZmsConfig{})
+	c := Client{Url: server.URL, Transport: transport, zmsClient: zms.NewClient(server.URL, transport)}
+	for i := 0; i < 5; i++ {
+		if _, err := c.GetDomain(context.Background(), "sys.auth"); err != nil {
+			t.Fatalf("GetDomain() error = %v", err)
+		}
+	}
+	if got := atomic.LoadInt32(&connections); got != 1 {
+		t.Errorf("server accepted %d connections, want 1", got)
+	}
+}
+
+func TestClientRequestTimeout(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	transport := newTransport(nil, &ZmsConfig{})
+	zmsClient := zms.NewClient(server.URL, transport)
+	zmsClient.Timeout = 100 * time.Millisecond
+	c := Client{Url: server.URL, Transport: transport, zmsClient: zmsClient, retryPolicy: newRetryPolicy(0, time.Second, nil)}
+
+	start := time.Now()
+	if _, err := c.GetDomain(context.Background(), "sys.auth"); err == nil {
+		t.Fatal("GetDomain() succeeded, want timeout error")
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("GetDomain() returned after %s, want request timeout to apply", elapsed)
+	}
+}
+
+func TestProxyFunc(t *testing.T) {
+	t.Setenv("HTTPS_PROXY", "http://env-proxy:3128")
+	t.Setenv("NO_PROXY", "")
+	tests := []struct {
+		name   string
+		config ZmsConfig
+		url    string
+		want   string
+	}{
+		{name: "environment", config: ZmsConfig{}, url: "https://zms.example.com/zms/v1", want: "http://env-proxy:3128"},
+		{name: "configured", config: ZmsConfig{ProxyUrl: "http://proxy:8080"}, url: "https://zms.example.com/zms/v1", want: "http://proxy:8080"},
+		{name: "no proxy", config: ZmsConfig{ProxyUrl: "http://proxy:8080", NoProxy: ".example.com"}, url: "https://zms.example.com/zms/v1", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodGet, tt.url, nil)
+			proxy, err := proxyFunc(&tt.config)(req)
+			if err != nil {
+				t.Fatalf("proxyFunc() error = %v", err)
+			}
+			got := ""
+			if proxy != nil {
+				got = proxy.String()
+			}
+			if got != tt.want {
+				t.Errorf("proxyFunc() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClientUsesProxy(t *testing.T) {
+	var proxied int32
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// a plain http request through a proxy carries the absolute url
+		if r.URL.Host == "zms.example.com" {
+			atomic.AddInt32(&proxied, 1)
+		}
+		_, _ = w.Write([]byte(`{"name":"sys.auth"}`))
+	}))
+	defer proxy.Close()
+
+	c, err := NewClient(&ZmsConfig{Url: "http://zms.example.com/zms/v1", AuthMode: AuthModeToken, AccessToken: "token", ProxyUrl: proxy.URL})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	if _, err := c.GetDomain(context.Background(), "sys.auth"); err != nil {
+		t.Fatalf("GetDomain() error = %v", err)
+	}
+	if atomic.LoadInt32(&proxied) != 1 {
+		t.Errorf("proxy saw %d requests for zms.example.com, want 1", proxied)
+	}
+}
+
+func TestClientSendsHeaders(t *testing.T) {
+	var userAgent, team atomic.Value
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgent.Store(r.Header.Get("User-Agent"))
+		team.Store(r.Header.Get("X-Team"))
+		_, _ = w.Write([]byte(`{"name":"sys.auth"}`))
+	}))
+	defer server.Close()
+
+	c, err := NewClient(&ZmsConfig{
+		Url:         server.URL,
+		AuthMode:    AuthModeToken,
+		AccessToken: "token",
+		UserAgent:   "terraform-provider-athenz/1.0.0",
+		Headers:     map[string]string{"X-Team": "platform"},
+	})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	if _, err := c.GetDomain(context.Background(), "sys.auth"); err != nil {
+		t.Fatalf("GetDomain() error = %v", err)
+	}
+	if got := userAgent.Load(); got != "terraform-provider-athenz/1.0.0" {
+		t.Errorf("server saw User-Agent %v, want terraform-provider-athenz/1.0.0", got)
+	}
+	if got := team.Load(); got != "platform" {
+		t.Errorf("server saw X-Team %v, want platform", got)
+	}
+}
+
+func TestBearerTokenOverridesAuthorizationHeader(t *testing.T) {
+	zmsServer := newBearerTestServer(t)
+	c, err := NewClient(&ZmsConfig{
+		Url:         zmsServer.URL,
+		AuthMode:    AuthModeToken,
+		AccessToken: "static-token",
+		Headers:     map[string]string{"Authorization": "Bearer other"},
+	})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	domain, err := c.GetDomain(context.Background(), "sys.auth")
+	if err != nil {
+		t.Fatalf("GetDomain() error = %v", err)
+	}
+	if domain.Name != "static-token" {
+		t.Fatalf("server saw token %s, want static-token", domain.Name)
+	}
+}
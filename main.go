@@ -7,6 +7,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
 )
 
+// version is set by goreleaser through -X main.version
+var version = "dev"
+
 func main() {
 
 	var debugMode bool
@@ -14,6 +17,8 @@ func main() {
 	flag.BoolVar(&debugMode, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
+	athenz.Version = version
+
 	opts := &plugin.ServeOpts{
 		Debug:        debugMode,
 		ProviderAddr: "yahoo/provider/athenz",